@@ -0,0 +1,242 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// EnableIpAccessLists is the workspace configuration key that turns IP access lists on
+const EnableIpAccessLists = "enableIpAccessLists"
+
+// SelfIpProbe is the value of `self_ip` provider attribute, that makes the provider probe
+// the public IP address of the caller from selfIpProbeUrl
+const SelfIpProbe = "probe"
+
+// selfIpProbeUrl returns the public IP address of the caller as plain text
+var selfIpProbeUrl = "https://checkip.amazonaws.com"
+
+const selfIpProbeTimeout = 10 * time.Second
+
+var errSelfIpNotConfigured = errors.New("self_ip isn't set")
+
+// callerIp returns the public IP address of the machine running Terraform, configured
+// explicitly with `self_ip` provider attribute. The address is probed from selfIpProbeUrl
+// only if `self_ip` is set to SelfIpProbe, because the probe calls a third-party service.
+func callerIp(ctx context.Context, c *common.DatabricksClient) (net.IP, error) {
+	configured := strings.TrimSpace(c.SelfIP())
+	if configured == "" {
+		return nil, errSelfIpNotConfigured
+	}
+	if configured != SelfIpProbe {
+		ip := net.ParseIP(configured)
+		if ip == nil {
+			return nil, fmt.Errorf("self_ip is not a valid IP address: %s", configured)
+		}
+		return ip, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, selfIpProbeTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, selfIpProbeUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("cannot probe public IP address: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 256))
+	if err != nil {
+		return nil, fmt.Errorf("cannot probe public IP address: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot probe public IP address: %s", resp.Status)
+	}
+	ip := net.ParseIP(strings.TrimSpace(string(body)))
+	if ip == nil {
+		return nil, fmt.Errorf("cannot probe public IP address: unexpected response %q", body)
+	}
+	return ip, nil
+}
+
+// parseIpRange converts either a single IP address or a CIDR into a network
+func parseIpRange(address string) (*net.IPNet, error) {
+	if !strings.Contains(address, "/") {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP address: %s", address)
+		}
+		if ip4 := ip.To4(); ip4 != nil {
+			return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+	_, network, err := net.ParseCIDR(address)
+	return network, err
+}
+
+// coversIpRange returns true if outer network fully contains the inner one
+func coversIpRange(outer, inner *net.IPNet) bool {
+	outerOnes, _ := outer.Mask.Size()
+	innerOnes, _ := inner.Mask.Size()
+	return outer.Contains(inner.IP) && outerOnes <= innerOnes
+}
+
+func containsIp(addresses []string, ip net.IP) bool {
+	for _, address := range addresses {
+		network, err := parseIpRange(address)
+		if err != nil {
+			continue
+		}
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkListConflicts returns an error when an entry of an enabled ALLOW list is
+// fully covered by an enabled BLOCK list, because it would have no effect.
+func checkListConflicts(lists []settings.IpAccessListInfo) error {
+	for _, allow := range lists {
+		if !allow.Enabled || allow.ListType != settings.ListTypeAllow {
+			continue
+		}
+		for _, block := range lists {
+			if !block.Enabled || block.ListType != settings.ListTypeBlock {
+				continue
+			}
+			for _, allowed := range allow.IpAddresses {
+				allowedRange, err := parseIpRange(allowed)
+				if err != nil {
+					continue
+				}
+				for _, blocked := range block.IpAddresses {
+					blockedRange, err := parseIpRange(blocked)
+					if err != nil {
+						continue
+					}
+					if coversIpRange(blockedRange, allowedRange) {
+						return fmt.Errorf("%s from ALLOW list %s is blocked by %s from BLOCK list %s",
+							allowed, allow.Label, blocked, block.Label)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkLockout returns an error if the given IP access lists would block the caller
+func checkLockout(lists []settings.IpAccessListInfo, ip net.IP) error {
+	hasAllowLists := false
+	allowed := false
+	for _, list := range lists {
+		if !list.Enabled {
+			continue
+		}
+		switch list.ListType {
+		case settings.ListTypeBlock:
+			if containsIp(list.IpAddresses, ip) {
+				return fmt.Errorf("BLOCK list %s contains the public IP address %s of this Terraform run. "+
+					"Set `force = true` if this is intended", list.Label, ip)
+			}
+		case settings.ListTypeAllow:
+			hasAllowLists = true
+			allowed = allowed || containsIp(list.IpAddresses, ip)
+		}
+	}
+	if hasAllowLists && !allowed {
+		return fmt.Errorf("none of ALLOW lists contains the public IP address %s of this Terraform run. "+
+			"Set `force = true` if this is intended", ip)
+	}
+	return nil
+}
+
+// ipAccessListsEnabled returns true if IP access lists are enforced in the workspace
+func ipAccessListsEnabled(ctx context.Context, w *databricks.WorkspaceClient) (bool, error) {
+	conf, err := w.WorkspaceConf.GetStatus(ctx, settings.GetStatusRequest{
+		Keys: EnableIpAccessLists,
+	})
+	if err != nil {
+		return false, err
+	}
+	if conf == nil {
+		return false, nil
+	}
+	return strings.EqualFold((*conf)[EnableIpAccessLists], "true"), nil
+}
+
+// withPendingList returns IP access lists of the workspace, with pending list replacing the
+// existing one with the same ID. Pending list could be nil.
+func withPendingList(ctx context.Context, w *databricks.WorkspaceClient,
+	pending *settings.IpAccessListInfo) ([]settings.IpAccessListInfo, error) {
+	existing, err := w.IpAccessLists.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lists := []settings.IpAccessListInfo{}
+	for _, list := range existing {
+		if pending != nil && list.ListId == pending.ListId {
+			continue
+		}
+		lists = append(lists, list)
+	}
+	if pending != nil {
+		lists = append(lists, *pending)
+	}
+	return lists, nil
+}
+
+// lockoutNotChecked is the warning, that the change is applied without the lockout check
+func lockoutNotChecked(err error) *common.Warning {
+	return &common.Warning{
+		Summary: "IP access list lockout check is skipped",
+		Detail: fmt.Sprintf("%s. Set `self_ip` in the provider configuration to the public IP address "+
+			"of this machine, or to %q to probe it. Set `force = true` to skip the check explicitly", err, SelfIpProbe),
+	}
+}
+
+// checkCallerLockout verifies that the given IP access lists don't block the caller. If the
+// public IP address of the caller isn't configured or can't be probed, it returns a warning.
+func checkCallerLockout(ctx context.Context, c *common.DatabricksClient,
+	lists []settings.IpAccessListInfo) (*common.Warning, error) {
+	if !slices.ContainsFunc(lists, func(list settings.IpAccessListInfo) bool {
+		return list.Enabled
+	}) {
+		return nil, nil
+	}
+	ip, err := callerIp(ctx, c)
+	if err != nil {
+		return lockoutNotChecked(err), nil
+	}
+	return nil, checkLockout(lists, ip)
+}
+
+// CheckIpAccessListsLockout verifies that IP access lists of the workspace, with pending
+// list replacing the existing one with the same ID, don't block the caller. Pending list
+// could be nil. If the public IP address of the caller isn't configured or can't be
+// probed, the check is skipped and the warning is returned, so that the caller could
+// report it after applying the change.
+func CheckIpAccessListsLockout(ctx context.Context, w *databricks.WorkspaceClient,
+	c *common.DatabricksClient, pending *settings.IpAccessListInfo) (*common.Warning, error) {
+	if strings.TrimSpace(c.SelfIP()) == "" {
+		return lockoutNotChecked(errSelfIpNotConfigured), nil
+	}
+	lists, err := withPendingList(ctx, w, pending)
+	if err != nil {
+		return nil, err
+	}
+	return checkCallerLockout(ctx, c, lists)
+}
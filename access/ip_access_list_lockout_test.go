@@ -0,0 +1,97 @@
+package access
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func probedSelfIp(t *testing.T, ip string) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(ip + "\n"))
+	}))
+	t.Cleanup(server.Close)
+	previous := selfIpProbeUrl
+	selfIpProbeUrl = server.URL
+	t.Cleanup(func() {
+		selfIpProbeUrl = previous
+	})
+}
+
+func TestCallerIp(t *testing.T) {
+	c := &common.DatabricksClient{}
+	c.SetSelfIP("192.168.1.1")
+	ip, err := callerIp(context.Background(), c)
+	require.NoError(t, err)
+	assert.Equal(t, "192.168.1.1", ip.String())
+
+	c.SetSelfIP("abc")
+	_, err = callerIp(context.Background(), c)
+	assert.EqualError(t, err, "self_ip is not a valid IP address: abc")
+
+	probedSelfIp(t, "10.0.0.1")
+	_, err = callerIp(context.Background(), &common.DatabricksClient{})
+	assert.ErrorIs(t, err, errSelfIpNotConfigured)
+
+	c.SetSelfIP(SelfIpProbe)
+	ip, err = callerIp(context.Background(), c)
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1", ip.String())
+
+	probedSelfIp(t, "<html>")
+	_, err = callerIp(context.Background(), c)
+	assert.EqualError(t, err, `cannot probe public IP address: unexpected response "<html>\n"`)
+}
+
+func TestCheckListConflicts(t *testing.T) {
+	assert.NoError(t, checkListConflicts([]settings.IpAccessListInfo{
+		{Label: "office", ListType: settings.ListTypeAllow, IpAddresses: []string{"10.0.0.0/8"}, Enabled: true},
+		{Label: "bad", ListType: settings.ListTypeBlock, IpAddresses: []string{"10.1.2.3"}, Enabled: true},
+		{Label: "disabled", ListType: settings.ListTypeBlock, IpAddresses: []string{"10.0.0.0/8"}},
+	}))
+	assert.EqualError(t, checkListConflicts([]settings.IpAccessListInfo{
+		{Label: "vpn", ListType: settings.ListTypeAllow, IpAddresses: []string{"10.1.2.0/24"}, Enabled: true},
+		{Label: "bad", ListType: settings.ListTypeBlock, IpAddresses: []string{"10.1.0.0/16"}, Enabled: true},
+	}), "10.1.2.0/24 from ALLOW list vpn is blocked by 10.1.0.0/16 from BLOCK list bad")
+}
+
+func TestCheckLockout(t *testing.T) {
+	ip := net.ParseIP("10.1.2.3")
+	assert.NoError(t, checkLockout([]settings.IpAccessListInfo{}, ip))
+	assert.NoError(t, checkLockout([]settings.IpAccessListInfo{
+		{Label: "a", ListType: settings.ListTypeAllow, IpAddresses: []string{"192.168.0.1"}, Enabled: true},
+		{Label: "b", ListType: settings.ListTypeAllow, IpAddresses: []string{"10.0.0.0/8"}, Enabled: true},
+	}, ip))
+	assert.NoError(t, checkLockout([]settings.IpAccessListInfo{
+		{Label: "a", ListType: settings.ListTypeAllow, IpAddresses: []string{"192.168.0.1"}},
+	}, ip))
+	assert.EqualError(t, checkLockout([]settings.IpAccessListInfo{
+		{Label: "a", ListType: settings.ListTypeAllow, IpAddresses: []string{"192.168.0.1"}, Enabled: true},
+	}, ip), "none of ALLOW lists contains the public IP address 10.1.2.3 of this Terraform run. "+
+		"Set `force = true` if this is intended")
+	assert.EqualError(t, checkLockout([]settings.IpAccessListInfo{
+		{Label: "b", ListType: settings.ListTypeBlock, IpAddresses: []string{"10.1.2.0/24"}, Enabled: true},
+	}, ip), "BLOCK list b contains the public IP address 10.1.2.3 of this Terraform run. "+
+		"Set `force = true` if this is intended")
+}
+
+func TestCheckIpAccessListsLockout_WithoutSelfIp(t *testing.T) {
+	warning, err := CheckIpAccessListsLockout(context.Background(), nil, &common.DatabricksClient{}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, warning)
+	assert.Equal(t, "IP access list lockout check is skipped", warning.Summary)
+	assert.Contains(t, warning.Detail, "self_ip isn't set. Set `self_ip` in the provider configuration")
+
+	warning, err = checkCallerLockout(context.Background(), &common.DatabricksClient{}, []settings.IpAccessListInfo{
+		{Label: "a", ListType: settings.ListTypeAllow, IpAddresses: []string{"192.168.0.1"}},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, warning, "no warning without enabled lists")
+}
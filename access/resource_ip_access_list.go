@@ -3,6 +3,7 @@ package access
 import (
 	"context"
 
+	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/settings"
 	"github.com/databricks/terraform-provider-databricks/common"
 
@@ -26,6 +27,10 @@ func ResourceIPAccessList() common.Resource {
 			Type:         schema.TypeString,
 			ValidateFunc: validation.Any(validation.IsIPv4Address, validation.IsCIDR),
 		}
+		s["force"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		}
 		return s
 	})
 	return common.Resource{
@@ -37,11 +42,23 @@ func ResourceIPAccessList() common.Resource {
 			}
 			var iacl settings.CreateIpAccessList
 			common.DataToStructPointer(d, s, &iacl)
+			warning, err := checkIpAccessListLockout(ctx, d, w, c, settings.IpAccessListInfo{
+				Label:       iacl.Label,
+				ListType:    iacl.ListType,
+				IpAddresses: iacl.IpAddresses,
+				Enabled:     true,
+			})
+			if err != nil {
+				return err
+			}
 			status, err := w.IpAccessLists.Create(ctx, iacl)
 			if err != nil {
 				return err
 			}
 			d.SetId(status.IpAccessList.ListId)
+			if warning != nil {
+				return warning
+			}
 			return nil
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -64,7 +81,24 @@ func ResourceIPAccessList() common.Resource {
 			var iacl settings.UpdateIpAccessList
 			common.DataToStructPointer(d, s, &iacl)
 			iacl.IpAccessListId = d.Id()
-			return w.IpAccessLists.Update(ctx, iacl)
+			warning, err := checkIpAccessListLockout(ctx, d, w, c, settings.IpAccessListInfo{
+				ListId:      iacl.IpAccessListId,
+				Label:       iacl.Label,
+				ListType:    iacl.ListType,
+				IpAddresses: iacl.IpAddresses,
+				Enabled:     iacl.Enabled,
+			})
+			if err != nil {
+				return err
+			}
+			err = w.IpAccessLists.Update(ctx, iacl)
+			if err != nil {
+				return err
+			}
+			if warning != nil {
+				return warning
+			}
+			return nil
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
@@ -75,3 +109,29 @@ func ResourceIPAccessList() common.Resource {
 		},
 	}
 }
+
+// checkIpAccessListLockout makes sure that the pending list won't lock the caller out of the
+// workspace and doesn't conflict with other lists, unless `force` is set or IP access lists
+// aren't enabled. The warning is returned, if the caller's IP address isn't known.
+func checkIpAccessListLockout(ctx context.Context, d *schema.ResourceData, w *databricks.WorkspaceClient,
+	c *common.DatabricksClient, pending settings.IpAccessListInfo) (*common.Warning, error) {
+	if d.Get("force").(bool) {
+		return nil, nil
+	}
+	enabled, err := ipAccessListsEnabled(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, nil
+	}
+	lists, err := withPendingList(ctx, w, &pending)
+	if err != nil {
+		return nil, err
+	}
+	err = checkListConflicts(lists)
+	if err != nil {
+		return nil, err
+	}
+	return checkCallerLockout(ctx, c, lists)
+}
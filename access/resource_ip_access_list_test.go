@@ -22,11 +22,20 @@ var (
 	TestingEnabled          = true
 	TestingIpAddresses      = []string{"1.2.3.4", "1.2.4.0/24"}
 	TestingIpAddressesState = []any{"1.2.3.4", "1.2.4.0/24"}
+
+	ipAccessListsDisabled = qa.HTTPFixture{
+		Method:   http.MethodGet,
+		Resource: "/api/2.0/workspace-conf?keys=enableIpAccessLists",
+		Response: map[string]string{
+			"enableIpAccessLists": "false",
+		},
+	}
 )
 
 func TestIPACLCreate(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			ipAccessListsDisabled,
 			{
 				Method:   http.MethodPost,
 				Resource: "/api/2.0/ip-access-lists",
@@ -88,6 +97,7 @@ func TestIPACLCreate(t *testing.T) {
 func TestAPIACLCreate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			ipAccessListsDisabled,
 			{
 				Method:   http.MethodPost,
 				Resource: "/api/2.0/ip-access-lists",
@@ -114,6 +124,7 @@ func TestAPIACLCreate_Error(t *testing.T) {
 func TestIPACLUpdate(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			ipAccessListsDisabled,
 			{
 				Method:   http.MethodGet,
 				Resource: "/api/2.0/ip-access-lists/" + TestingId + "?",
@@ -177,6 +188,7 @@ func TestIPACLUpdate(t *testing.T) {
 func TestIPACLUpdate_Error(t *testing.T) {
 	_, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			ipAccessListsDisabled,
 			{
 				Method:   http.MethodPatch,
 				Resource: "/api/2.0/ip-access-lists/" + TestingId,
@@ -309,3 +321,214 @@ func TestIPACLDelete_Error(t *testing.T) {
 		ID:       TestingId,
 	}.ExpectError(t, "Something went wrong")
 }
+
+func TestIPACLCreate_Lockout(t *testing.T) {
+	probedSelfIp(t, "10.1.2.3")
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enableIpAccessLists",
+				Response: map[string]string{
+					"enableIpAccessLists": "true",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/ip-access-lists",
+				Response: settings.ListIpAccessListResponse{
+					IpAccessLists: []settings.IpAccessListInfo{
+						{
+							ListId:      "123",
+							Label:       "office",
+							ListType:    settings.ListTypeAllow,
+							IpAddresses: []string{"10.0.0.0/8"},
+							Enabled:     true,
+						},
+					},
+				},
+			},
+		},
+		SelfIP:   SelfIpProbe,
+		Resource: ResourceIPAccessList(),
+		HCL: `
+		label        = "vpn"
+		list_type    = "BLOCK"
+		ip_addresses = ["10.1.0.0/16"]`,
+		Create: true,
+	}.ExpectError(t, "BLOCK list vpn contains the public IP address 10.1.2.3 of this Terraform run. "+
+		"Set `force = true` if this is intended")
+}
+
+func TestIPACLUpdate_ReplacesExistingListInLockoutCheck(t *testing.T) {
+	probedSelfIp(t, "10.1.2.3")
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enableIpAccessLists",
+				Response: map[string]string{
+					"enableIpAccessLists": "true",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/ip-access-lists",
+				Response: settings.ListIpAccessListResponse{
+					IpAccessLists: []settings.IpAccessListInfo{
+						{
+							ListId:      TestingId,
+							Label:       "office",
+							ListType:    settings.ListTypeAllow,
+							IpAddresses: []string{"10.0.0.0/8"},
+							Enabled:     true,
+						},
+					},
+				},
+			},
+		},
+		SelfIP:   SelfIpProbe,
+		Resource: ResourceIPAccessList(),
+		HCL: `
+		label        = "office"
+		list_type    = "ALLOW"
+		ip_addresses = ["192.168.0.0/16"]`,
+		Update: true,
+		ID:     TestingId,
+	}.ExpectError(t, "none of ALLOW lists contains the public IP address 10.1.2.3 of this Terraform run. "+
+		"Set `force = true` if this is intended")
+}
+
+func TestIPACLCreate_Force(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/ip-access-lists",
+				ExpectedRequest: settings.CreateIpAccessList{
+					Label:       "office",
+					ListType:    settings.ListTypeAllow,
+					IpAddresses: []string{"192.168.0.0/16"},
+				},
+				Response: settings.CreateIpAccessListResponse{
+					IpAccessList: &settings.IpAccessListInfo{
+						ListId: TestingId,
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/ip-access-lists/" + TestingId + "?",
+				Response: settings.FetchIpAccessListResponse{
+					IpAccessList: &settings.IpAccessListInfo{
+						ListId:      TestingId,
+						Label:       "office",
+						ListType:    settings.ListTypeAllow,
+						IpAddresses: []string{"192.168.0.0/16"},
+						Enabled:     true,
+					},
+				},
+			},
+		},
+		Resource: ResourceIPAccessList(),
+		HCL: `
+		label        = "office"
+		list_type    = "ALLOW"
+		ip_addresses = ["192.168.0.0/16"]
+		force        = true`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":    TestingId,
+		"force": true,
+	})
+}
+
+func TestIPACLCreate_ConflictingLists(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enableIpAccessLists",
+				Response: map[string]string{
+					"enableIpAccessLists": "true",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/ip-access-lists",
+				Response: settings.ListIpAccessListResponse{
+					IpAccessLists: []settings.IpAccessListInfo{
+						{
+							ListId:      "234",
+							Label:       "bad",
+							ListType:    settings.ListTypeBlock,
+							IpAddresses: []string{"10.1.0.0/16"},
+							Enabled:     true,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceIPAccessList(),
+		HCL: `
+		label        = "vpn"
+		list_type    = "ALLOW"
+		ip_addresses = ["10.1.2.0/24"]`,
+		Create: true,
+	}.ExpectError(t, "10.1.2.0/24 from ALLOW list vpn is blocked by 10.1.0.0/16 from BLOCK list bad")
+}
+
+func TestIPACLCreate_WithoutSelfIp(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enableIpAccessLists",
+				Response: map[string]string{
+					"enableIpAccessLists": "true",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/ip-access-lists",
+				Response: settings.ListIpAccessListResponse{},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/ip-access-lists",
+				ExpectedRequest: settings.CreateIpAccessList{
+					Label:       "office",
+					ListType:    settings.ListTypeAllow,
+					IpAddresses: []string{"10.0.0.0/8", "10.1.2.3"},
+				},
+				Response: settings.CreateIpAccessListResponse{
+					IpAccessList: &settings.IpAccessListInfo{
+						ListId: TestingId,
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/ip-access-lists/" + TestingId + "?",
+				Response: settings.FetchIpAccessListResponse{
+					IpAccessList: &settings.IpAccessListInfo{
+						ListId:      TestingId,
+						Label:       "office",
+						ListType:    settings.ListTypeAllow,
+						IpAddresses: []string{"10.0.0.0/8", "10.1.2.3"},
+						Enabled:     true,
+					},
+				},
+			},
+		},
+		Resource: ResourceIPAccessList(),
+		HCL: `
+		label        = "office"
+		list_type    = "ALLOW"
+		ip_addresses = ["10.0.0.0/8", "10.1.2.3"]`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":             TestingId,
+		"ip_addresses.#": 2,
+	})
+}
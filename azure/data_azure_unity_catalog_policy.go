@@ -0,0 +1,151 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+var AzureEnvironments = []string{"public", "usgovernment", "china"}
+var AzureEnvironmentsValidationError = "azure_environment must be either 'public' or 'usgovernment' or 'china'"
+
+var azureDfsSuffix = map[string]string{
+	"public":       "dfs.core.windows.net",
+	"usgovernment": "dfs.core.usgovcloudapi.net",
+	"china":        "dfs.core.chinacloudapi.cn",
+}
+
+var storageAccountIdRegex = regexp.MustCompile(
+	`(?i)^/subscriptions/([^/]+)/resourceGroups/([^/]+)/providers/Microsoft\.Storage/storageAccounts/([^/]+)$`)
+
+// IDs of built-in roles are the same in all Azure environments
+const (
+	storageBlobDataContributor            = "ba92f5b4-2d11-453d-a403-e96b0029c9fe"
+	storageAccountContributor             = "17d1049b-9a84-46fb-8f53-869881c3d3ab"
+	storageQueueDataContributor           = "974c5e8b-45b9-4653-ba55-5f855dd0fb88"
+	eventGridEventSubscriptionContributor = "428e0ff0-5e57-4d9c-a221-2c70d0e0a443"
+)
+
+// azureRoleDefinition is the format of `az role definition create --role-definition`
+type azureRoleDefinition struct {
+	Name             string   `json:"Name"`
+	IsCustom         bool     `json:"IsCustom"`
+	Description      string   `json:"Description"`
+	Actions          []string `json:"Actions"`
+	NotActions       []string `json:"NotActions"`
+	DataActions      []string `json:"DataActions"`
+	NotDataActions   []string `json:"NotDataActions"`
+	AssignableScopes []string `json:"AssignableScopes"`
+}
+
+type AzureRoleAssignment struct {
+	RoleDefinitionName string `json:"role_definition_name"`
+	RoleDefinitionId   string `json:"role_definition_id"`
+	Scope              string `json:"scope"`
+}
+
+// DataAzureUnityCatalogPolicy defines roles that an access connector needs on a storage account
+func DataAzureUnityCatalogPolicy() common.Resource {
+	type AzureUcPolicy struct {
+		StorageAccountId string                `json:"storage_account_id"`
+		ContainerName    string                `json:"container_name,omitempty"`
+		AzureEnvironment string                `json:"azure_environment,omitempty" tf:"default:public"`
+		FileEvents       bool                  `json:"file_events,omitempty" tf:"default:true"`
+		RoleAssignments  []AzureRoleAssignment `json:"role_assignment,omitempty" tf:"computed"`
+		URL              string                `json:"url,omitempty" tf:"computed"`
+		JSON             string                `json:"json" tf:"computed"`
+		Id               string                `json:"id" tf:"computed"`
+	}
+	return common.NoClientData(func(ctx context.Context, data *AzureUcPolicy) error {
+		if !slices.Contains(AzureEnvironments, data.AzureEnvironment) {
+			return errors.New(AzureEnvironmentsValidationError)
+		}
+		match := storageAccountIdRegex.FindStringSubmatch(data.StorageAccountId)
+		if match == nil {
+			return fmt.Errorf("storage_account_id must be a resource ID of a storage account, like " +
+				"/subscriptions/<subscription>/resourceGroups/<group>/providers/Microsoft.Storage/storageAccounts/<name>")
+		}
+		subscription, resourceGroup, accountName := match[1], match[2], match[3]
+		accountScope := data.StorageAccountId
+		resourceGroupScope := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", subscription, resourceGroup)
+		// in the restricted mode, access to data is limited to a single container
+		dataScope := accountScope
+		data.URL = fmt.Sprintf("abfss://<container>@%s.%s/", accountName, azureDfsSuffix[data.AzureEnvironment])
+		if data.ContainerName != "" {
+			dataScope = fmt.Sprintf("%s/blobServices/default/containers/%s", accountScope, data.ContainerName)
+			data.URL = strings.Replace(data.URL, "<container>", data.ContainerName, 1)
+		}
+		assignment := func(name, id, scope string) AzureRoleAssignment {
+			return AzureRoleAssignment{
+				RoleDefinitionName: name,
+				RoleDefinitionId: fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Authorization/roleDefinitions/%s",
+					subscription, id),
+				Scope: scope,
+			}
+		}
+		data.RoleAssignments = []AzureRoleAssignment{
+			assignment("Storage Blob Data Contributor", storageBlobDataContributor, dataScope),
+		}
+		role := azureRoleDefinition{
+			Name:        fmt.Sprintf("Databricks Unity Catalog access to %s", accountName),
+			IsCustom:    true,
+			Description: "Permissions of a Databricks access connector for Unity Catalog storage",
+			Actions: []string{
+				"Microsoft.Storage/storageAccounts/read",
+				"Microsoft.Storage/storageAccounts/blobServices/containers/read",
+				"Microsoft.Storage/storageAccounts/blobServices/generateUserDelegationKey/action",
+			},
+			NotActions: []string{},
+			DataActions: []string{
+				"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read",
+				"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/write",
+				"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/delete",
+				"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/add/action",
+				"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/move/action",
+			},
+			NotDataActions:   []string{},
+			AssignableScopes: []string{dataScope},
+		}
+		if data.FileEvents {
+			// file events are set up with Event Grid subscriptions and storage queues of the whole account
+			data.RoleAssignments = append(data.RoleAssignments,
+				assignment("Storage Account Contributor", storageAccountContributor, accountScope),
+				assignment("Storage Queue Data Contributor", storageQueueDataContributor, accountScope),
+				assignment("EventGrid EventSubscription Contributor", eventGridEventSubscriptionContributor,
+					resourceGroupScope))
+			role.Actions = append(role.Actions,
+				"Microsoft.Storage/storageAccounts/queueServices/queues/read",
+				"Microsoft.Storage/storageAccounts/queueServices/queues/write",
+				"Microsoft.Storage/storageAccounts/queueServices/queues/delete",
+				"Microsoft.EventGrid/eventSubscriptions/read",
+				"Microsoft.EventGrid/eventSubscriptions/write",
+				"Microsoft.EventGrid/eventSubscriptions/delete",
+				"Microsoft.EventGrid/systemTopics/read",
+				"Microsoft.EventGrid/systemTopics/write",
+				"Microsoft.EventGrid/systemTopics/eventSubscriptions/read",
+				"Microsoft.EventGrid/systemTopics/eventSubscriptions/write",
+				"Microsoft.EventGrid/systemTopics/eventSubscriptions/delete",
+			)
+			role.DataActions = append(role.DataActions,
+				"Microsoft.Storage/storageAccounts/queueServices/queues/messages/read",
+				"Microsoft.Storage/storageAccounts/queueServices/queues/messages/write",
+				"Microsoft.Storage/storageAccounts/queueServices/queues/messages/delete",
+				"Microsoft.Storage/storageAccounts/queueServices/queues/messages/process/action",
+			)
+			role.AssignableScopes = []string{resourceGroupScope}
+		}
+		roleJSON, err := json.MarshalIndent(role, "", "  ")
+		if err != nil {
+			return err
+		}
+		data.JSON = string(roleJSON)
+		data.Id = dataScope
+		return nil
+	})
+}
@@ -0,0 +1,66 @@
+package azure
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStorageAccountId = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/ucdata"
+
+func TestDataAzureUnityCatalogPolicy(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataAzureUnityCatalogPolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL:         `storage_account_id = "` + testStorageAccountId + `"`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "abfss://<container>@ucdata.dfs.core.windows.net/", d.Get("url"))
+	assert.Equal(t, 4, d.Get("role_assignment.#"))
+	assert.Equal(t, "Storage Blob Data Contributor", d.Get("role_assignment.0.role_definition_name"))
+	assert.Equal(t, "/subscriptions/sub/providers/Microsoft.Authorization/roleDefinitions/ba92f5b4-2d11-453d-a403-e96b0029c9fe",
+		d.Get("role_assignment.0.role_definition_id"))
+	assert.Equal(t, testStorageAccountId, d.Get("role_assignment.0.scope"))
+	assert.Equal(t, "EventGrid EventSubscription Contributor", d.Get("role_assignment.3.role_definition_name"))
+	assert.Equal(t, "/subscriptions/sub/resourceGroups/rg", d.Get("role_assignment.3.scope"))
+	assert.Contains(t, d.Get("json"), "Microsoft.EventGrid/eventSubscriptions/write")
+}
+
+func TestDataAzureUnityCatalogPolicy_Restricted(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataAzureUnityCatalogPolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL: `
+		storage_account_id = "` + testStorageAccountId + `"
+		container_name = "metastore"
+		azure_environment = "usgovernment"
+		file_events = false`,
+	}.Apply(t)
+	require.NoError(t, err)
+	containerScope := testStorageAccountId + "/blobServices/default/containers/metastore"
+	assert.Equal(t, "abfss://metastore@ucdata.dfs.core.usgovcloudapi.net/", d.Get("url"))
+	assert.Equal(t, 1, d.Get("role_assignment.#"))
+	assert.Equal(t, containerScope, d.Get("role_assignment.0.scope"))
+	assert.Equal(t, containerScope, d.Id())
+	assert.NotContains(t, d.Get("json"), "Microsoft.EventGrid")
+	assert.Contains(t, d.Get("json"), `"AssignableScopes": [
+    "`+containerScope+`"
+  ]`)
+}
+
+func TestDataAzureUnityCatalogPolicy_InvalidStorageAccount(t *testing.T) {
+	qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataAzureUnityCatalogPolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL:         `storage_account_id = "ucdata"`,
+	}.ExpectError(t, "storage_account_id must be a resource ID of a storage account, like "+
+		"/subscriptions/<subscription>/resourceGroups/<group>/providers/Microsoft.Storage/storageAccounts/<name>")
+}
@@ -0,0 +1,140 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type sqlQueryResultColumn struct {
+	Name     string `json:"name"`
+	Position int    `json:"position"`
+	TypeName string `json:"type_name,omitempty"`
+	TypeText string `json:"type_text,omitempty"`
+}
+
+type sqlQueryResultData struct {
+	WarehouseID    string                  `json:"warehouse_id"`
+	Statement      string                  `json:"statement"`
+	Catalog        string                  `json:"catalog,omitempty"`
+	Schema         string                  `json:"schema,omitempty"`
+	Parameters     []SqlStatementParameter `json:"parameter,omitempty"`
+	RowLimit       int                     `json:"row_limit,omitempty" tf:"default:1000"`
+	TimeoutSeconds int                     `json:"timeout_seconds,omitempty" tf:"default:300"`
+	Columns        []sqlQueryResultColumn  `json:"columns,omitempty" tf:"computed"`
+	Truncated      bool                    `json:"truncated,omitempty" tf:"computed"`
+}
+
+var (
+	sqlCommentRegex      = regexp.MustCompile(`(?s)--[^\n]*|/\*.*?\*/`)
+	sqlStringRegex       = regexp.MustCompile("'(?:[^'\\\\]|\\\\.)*'|\"(?:[^\"\\\\]|\\\\.)*\"|`[^`]*`")
+	sqlModifyingKeywords = regexp.MustCompile(`(?i)\b(INSERT|UPDATE|DELETE|MERGE|CREATE|ALTER|DROP|TRUNCATE|GRANT|REVOKE|COPY|OPTIMIZE|VACUUM)\b`)
+	sqlReadOnlyCommands  = []string{"SELECT", "WITH", "SHOW", "DESCRIBE", "DESC", "EXPLAIN", "VALUES", "TABLE", "LIST"}
+)
+
+// checkReadOnlySql returns an error if the statement isn't a single read-only query.
+func checkReadOnlySql(statement string) error {
+	// string literals and quoted identifiers may contain anything, so they are excluded from the check
+	stripped := sqlStringRegex.ReplaceAllString(statement, "''")
+	stripped = strings.TrimSpace(sqlCommentRegex.ReplaceAllString(stripped, " "))
+	stripped = strings.TrimSpace(strings.TrimSuffix(stripped, ";"))
+	if stripped == "" {
+		return fmt.Errorf("statement is empty")
+	}
+	if strings.Contains(stripped, ";") {
+		return fmt.Errorf("only a single statement is allowed")
+	}
+	command := strings.ToUpper(strings.Fields(stripped)[0])
+	for _, allowed := range sqlReadOnlyCommands {
+		if command != allowed {
+			continue
+		}
+		if command == "WITH" || command == "SELECT" {
+			if keyword := sqlModifyingKeywords.FindString(stripped); keyword != "" {
+				return fmt.Errorf("only read-only statements are allowed, found %s", strings.ToUpper(keyword))
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("only read-only statements are allowed, got %s", command)
+}
+
+// sqlStatementWaitTimeout returns the synchronous wait timeout for the given overall timeout,
+// which must be either 0 or between 5 and 50 seconds.
+func sqlStatementWaitTimeout(timeoutSeconds int) string {
+	wait := min(max(timeoutSeconds, 5), MaxSqlExecWaitTimeout)
+	return fmt.Sprintf("%ds", wait)
+}
+
+// DataSourceSqlQueryResult runs a read-only query on a SQL warehouse and returns its rows.
+func DataSourceSqlQueryResult() common.Resource {
+	s := common.StructToSchema(sqlQueryResultData{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		m["rows"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+		}
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var data sqlQueryResultData
+			common.DataToStructPointer(d, s, &data)
+			if err := checkReadOnlySql(data.Statement); err != nil {
+				return err
+			}
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			stmt := SqlStatement{
+				WarehouseID:    data.WarehouseID,
+				Catalog:        data.Catalog,
+				Schema:         data.Schema,
+				Parameters:     data.Parameters,
+				StoreResult:    true,
+				ResultRowLimit: data.RowLimit,
+			}
+			req := stmt.request(data.Statement)
+			req.WaitTimeout = sqlStatementWaitTimeout(data.TimeoutSeconds)
+			execCtx, cancel := context.WithTimeout(ctx, time.Duration(data.TimeoutSeconds)*time.Second)
+			defer cancel()
+			res, err := executeSqlStatement(execCtx, w.StatementExecution, req)
+			if err != nil {
+				return err
+			}
+			rows, err := fetchSqlStatementRows(execCtx, w.StatementExecution, res, data.RowLimit)
+			if err != nil {
+				return err
+			}
+			data.Columns = []sqlQueryResultColumn{}
+			for _, column := range sqlStatementColumns(res) {
+				data.Columns = append(data.Columns, sqlQueryResultColumn{
+					Name:     column.Name,
+					Position: column.Position,
+					TypeName: string(column.TypeName),
+					TypeText: column.TypeText,
+				})
+			}
+			data.Truncated = res.Manifest != nil && res.Manifest.Truncated
+			if err = common.StructToData(data, s, d); err != nil {
+				return err
+			}
+			result := make([]any, 0, len(rows))
+			for _, row := range rows {
+				result = append(result, row)
+			}
+			d.SetId(res.StatementId)
+			return d.Set("rows", result)
+		},
+	}
+}
@@ -0,0 +1,127 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSqlQueryResultData(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockStatementExecutionAPI().EXPECT().ExecuteStatement(mock.Anything, sql.ExecuteStatementRequest{
+				Statement:     "SELECT name, enabled FROM main.control.flags WHERE env = :env",
+				WarehouseId:   "abc",
+				WaitTimeout:   "30s",
+				OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+				Disposition:   sql.DispositionInline,
+				Format:        sql.FormatJsonArray,
+				RowLimit:      10,
+				Parameters: []sql.StatementParameterListItem{
+					{Name: "env", Value: "prod"},
+				},
+			}).Return(&sql.StatementResponse{
+				StatementId: "s1",
+				Status:      &sql.StatementStatus{State: sql.StatementStateSucceeded},
+				Manifest: &sql.ResultManifest{
+					Schema: &sql.ResultSchema{
+						Columns: []sql.ColumnInfo{
+							{Name: "name", Position: 0, TypeName: sql.ColumnInfoTypeNameString, TypeText: "STRING"},
+							{Name: "enabled", Position: 1, TypeName: sql.ColumnInfoTypeNameBoolean, TypeText: "BOOLEAN"},
+						},
+					},
+				},
+				Result: &sql.ResultData{
+					DataArray: [][]string{{"new_ui", "true"}, {"beta", "false"}},
+				},
+			}, nil)
+		},
+		Resource: DataSourceSqlQueryResult(),
+		HCL: `
+		warehouse_id    = "abc"
+		statement       = "SELECT name, enabled FROM main.control.flags WHERE env = :env"
+		row_limit       = 10
+		timeout_seconds = 30
+		parameter {
+			name  = "env"
+			value = "prod"
+		}`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                  "s1",
+		"rows.#":              2,
+		"rows.0.name":         "new_ui",
+		"rows.0.enabled":      "true",
+		"rows.1.name":         "beta",
+		"columns.#":           2,
+		"columns.1.name":      "enabled",
+		"columns.1.position":  1,
+		"columns.1.type_name": "BOOLEAN",
+		"truncated":           false,
+	})
+}
+
+func TestSqlQueryResultData_RejectsModifyingStatement(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: DataSourceSqlQueryResult(),
+		HCL: `
+		warehouse_id = "abc"
+		statement    = "DROP TABLE main.control.flags"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "only read-only statements are allowed, got DROP")
+}
+
+func TestSqlQueryResultData_Failed(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockStatementExecutionAPI().EXPECT().ExecuteStatement(mock.Anything, mock.Anything).Return(&sql.StatementResponse{
+				StatementId: "s1",
+				Status:      &sql.StatementStatus{State: sql.StatementStateCanceled},
+			}, nil)
+		},
+		Resource: DataSourceSqlQueryResult(),
+		HCL: `
+		warehouse_id = "abc"
+		statement    = "SELECT 1"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "statement failed to execute: CANCELED")
+}
+
+func TestCheckReadOnlySql(t *testing.T) {
+	for _, statement := range []string{
+		"SELECT * FROM a",
+		"  select 1;",
+		"-- leading comment\nSHOW TABLES IN main.default",
+		"/* block */ DESCRIBE TABLE a",
+		"WITH x AS (SELECT 1) SELECT * FROM x",
+		"SELECT 'drop table a; insert' AS s",
+		"SELECT `update` FROM a",
+	} {
+		assert.NoError(t, checkReadOnlySql(statement), statement)
+	}
+	for statement, message := range map[string]string{
+		"":                                 "statement is empty",
+		"-- only comment":                  "statement is empty",
+		"SELECT 1; DROP TABLE a":           "only a single statement is allowed",
+		"INSERT INTO a VALUES (1)":         "only read-only statements are allowed, got INSERT",
+		"WITH x AS (SELECT 1) INSERT INTO": "only read-only statements are allowed, found INSERT",
+	} {
+		assert.EqualError(t, checkReadOnlySql(statement), message, statement)
+	}
+}
+
+func TestSqlStatementWaitTimeout(t *testing.T) {
+	assert.Equal(t, "5s", sqlStatementWaitTimeout(1))
+	assert.Equal(t, "30s", sqlStatementWaitTimeout(30))
+	assert.Equal(t, "50s", sqlStatementWaitTimeout(300))
+}
@@ -59,7 +59,8 @@ func ResourceCatalog() common.Resource {
 			return s
 		})
 	return common.Resource{
-		Schema: catalogSchema,
+		Schema:   catalogSchema,
+		Identity: common.NewIdentity("name").Mutable(),
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
@@ -780,3 +780,7 @@ func TestCatalogSuppressCaseSensitivity(t *testing.T) {
 		`,
 	}.ApplyNoError(t)
 }
+
+func TestCatalogIdentityChangesOnRename(t *testing.T) {
+	assert.True(t, ResourceCatalog().ToResource().ResourceBehavior.MutableIdentity)
+}
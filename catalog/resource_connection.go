@@ -2,11 +2,14 @@ package catalog
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
 
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"golang.org/x/exp/slices"
 )
 
@@ -21,6 +24,25 @@ func suppressPemPrivateKeyExpiration(k, old, new string, d *schema.ResourceData)
 	return false
 }
 
+// mergeWriteOnlyOptions adds options from the `options_wo` JSON object, that is never stored in the state
+func mergeWriteOnlyOptions(d *schema.ResourceData, options map[string]string) (map[string]string, error) {
+	wo := common.GetWriteOnlyValue(d, "options")
+	if wo == "" {
+		return options, nil
+	}
+	var woOptions map[string]string
+	if err := json.Unmarshal([]byte(wo), &woOptions); err != nil {
+		return nil, fmt.Errorf("options_wo must be a JSON object with string values: %w", err)
+	}
+	if options == nil {
+		options = map[string]string{}
+	}
+	for k, v := range woOptions {
+		options[k] = v
+	}
+	return options, nil
+}
+
 func ResourceConnection() common.Resource {
 	s := common.StructToSchema(catalog.ConnectionInfo{},
 		func(m map[string]*schema.Schema) map[string]*schema.Schema {
@@ -36,6 +58,18 @@ func ResourceConnection() common.Resource {
 			}
 			common.CustomizeSchemaPath(m, "options").SetSensitive().SetCustomSuppressDiff(suppressPemPrivateKeyExpiration)
 			common.CustomizeSchemaPath(m, "name").SetCustomSuppressDiff(common.EqualFoldDiffSuppress)
+			m[common.WriteOnlyName("options")] = &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ValidateFunc: validation.StringIsJSON,
+			}
+			m[common.WriteOnlyVersionName("options")] = &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{common.WriteOnlyName("options")},
+			}
 
 			return m
 		})
@@ -44,7 +78,8 @@ func ResourceConnection() common.Resource {
 			return s
 		})
 	return common.Resource{
-		Schema: s,
+		Schema:   s,
+		Identity: pi.Identity(),
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
@@ -56,6 +91,10 @@ func ResourceConnection() common.Resource {
 			}
 			var createConnectionRequest catalog.CreateConnection
 			common.DataToStructPointer(d, s, &createConnectionRequest)
+			createConnectionRequest.Options, err = mergeWriteOnlyOptions(d, createConnectionRequest.Options)
+			if err != nil {
+				return err
+			}
 			conn, err := w.Connections.Create(ctx, createConnectionRequest)
 			if err != nil {
 				return err
@@ -65,6 +104,7 @@ func ResourceConnection() common.Resource {
 				var updateConnectionRequest catalog.UpdateConnection
 				common.DataToStructPointer(d, s, &updateConnectionRequest)
 				updateConnectionRequest.Name = createConnectionRequest.Name
+				updateConnectionRequest.Options = createConnectionRequest.Options
 				conn, err = w.Connections.Update(ctx, updateConnectionRequest)
 				if err != nil {
 					return err
@@ -119,6 +159,10 @@ func ResourceConnection() common.Resource {
 			}
 			var updateConnectionRequest catalog.UpdateConnection
 			common.DataToStructPointer(d, s, &updateConnectionRequest)
+			updateConnectionRequest.Options, err = mergeWriteOnlyOptions(d, updateConnectionRequest.Options)
+			if err != nil {
+				return err
+			}
 			_, connName, err := pi.Unpack(d)
 			if err != nil {
 				return err
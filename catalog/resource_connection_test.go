@@ -512,3 +512,75 @@ func TestConnectionDelete_Error(t *testing.T) {
 		ID:       "abc|testConnectionName",
 	}.ExpectError(t, "Something went wrong")
 }
+
+func TestConnectionsCreate_WriteOnlyOptions(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.1/unity-catalog/connections",
+				ExpectedRequest: catalog.CreateConnection{
+					Name:           "testConnectionName",
+					ConnectionType: catalog.ConnectionType("testConnectionType"),
+					Options: map[string]string{
+						"host":     "test.com",
+						"user":     "testUser",
+						"password": "testPassword",
+					},
+				},
+				Response: catalog.ConnectionInfo{
+					Name:           "testConnectionName",
+					ConnectionType: catalog.ConnectionType("testConnectionType"),
+					FullName:       "testConnectionName",
+					MetastoreId:    "abc",
+					Owner:          "InitialOwner",
+					Options: map[string]string{
+						"host": "test.com",
+					},
+				},
+			},
+			{
+				Method:       http.MethodGet,
+				Resource:     "/api/2.1/unity-catalog/connections/testConnectionName?",
+				ReuseRequest: true,
+				Response: catalog.ConnectionInfo{
+					Name:           "testConnectionName",
+					ConnectionType: catalog.ConnectionType("testConnectionType"),
+					FullName:       "testConnectionName",
+					Owner:          "InitialOwner",
+					MetastoreId:    "abc",
+					Options: map[string]string{
+						"host": "test.com",
+					},
+				},
+			},
+		},
+		Resource: ResourceConnection(),
+		Create:   true,
+		HCL: `
+		name = "testConnectionName"
+		connection_type = "testConnectionType"
+		options = {
+			host = "test.com"
+		}
+		options_wo = "{\"user\": \"testUser\", \"password\": \"testPassword\"}"
+		options_wo_version = 1
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"options":            map[string]any{"host": "test.com"},
+		"options_wo_version": 1,
+	})
+}
+
+func TestConnectionsCreate_WriteOnlyOptionsInvalid(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceConnection(),
+		Create:   true,
+		HCL: `
+		name = "testConnectionName"
+		connection_type = "testConnectionType"
+		options_wo = "[\"user\"]"
+		options_wo_version = 1
+		`,
+	}.ExpectError(t, "options_wo must be a JSON object with string values: json: cannot unmarshal array into Go value of type map[string]string")
+}
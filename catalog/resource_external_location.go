@@ -60,7 +60,8 @@ func ResourceExternalLocation() common.Resource {
 			return m
 		})
 	return common.Resource{
-		Schema: s,
+		Schema:   s,
+		Identity: common.NewIdentity("name"),
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
@@ -158,7 +158,8 @@ func ResourceGrants() common.Resource {
 			return s
 		})
 	return common.Resource{
-		Schema: s,
+		Schema:   s,
+		Identity: common.NewSeparatedIdentity("/", "securable_type", "name"),
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
@@ -41,7 +41,8 @@ func ResourceSchema() common.Resource {
 			return s
 		})
 	return common.Resource{
-		Schema: s,
+		Schema:   s,
+		Identity: common.NewSeparatedIdentity(".", "catalog_name", "name").Mutable(),
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
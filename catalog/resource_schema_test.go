@@ -8,6 +8,7 @@ import (
 	"github.com/databricks/databricks-sdk-go/experimental/mocks"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -419,3 +420,7 @@ func TestForceDeleteSchema(t *testing.T) {
 		`,
 	}.ApplyNoError(t)
 }
+
+func TestSchemaIdentityChangesOnRename(t *testing.T) {
+	assert.True(t, ResourceSchema().ToResource().ResourceBehavior.MutableIdentity)
+}
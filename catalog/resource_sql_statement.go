@@ -0,0 +1,169 @@
+package catalog
+
+import (
+	"context"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const sqlStatementDefaultTimeout = 20 * time.Minute
+
+type SqlStatementParameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+	Type  string `json:"type,omitempty"`
+}
+
+type SqlStatement struct {
+	WarehouseID      string                  `json:"warehouse_id"`
+	CreateStatement  string                  `json:"create_statement" tf:"force_new"`
+	UpdateStatement  string                  `json:"update_statement,omitempty"`
+	DestroyStatement string                  `json:"destroy_statement,omitempty"`
+	Catalog          string                  `json:"catalog,omitempty" tf:"force_new"`
+	Schema           string                  `json:"schema,omitempty" tf:"force_new"`
+	Parameters       []SqlStatementParameter `json:"parameter,omitempty"`
+	Triggers         map[string]string       `json:"triggers,omitempty"`
+	StoreResult      bool                    `json:"store_result,omitempty"`
+	ResultRowLimit   int                     `json:"result_row_limit,omitempty" tf:"default:1000"`
+}
+
+func (s SqlStatement) request(statement string) sql.ExecuteStatementRequest {
+	req := sql.ExecuteStatementRequest{
+		Statement:     statement,
+		WarehouseId:   s.WarehouseID,
+		Catalog:       s.Catalog,
+		Schema:        s.Schema,
+		OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+	}
+	for _, p := range s.Parameters {
+		req.Parameters = append(req.Parameters, sql.StatementParameterListItem{
+			Name:  p.Name,
+			Value: p.Value,
+			Type:  p.Type,
+		})
+	}
+	if s.StoreResult {
+		req.Disposition = sql.DispositionInline
+		req.Format = sql.FormatJsonArray
+		req.RowLimit = int64(s.ResultRowLimit)
+	}
+	return req
+}
+
+// run executes the given statement and stores its result rows if store_result is set.
+func (s SqlStatement) run(ctx context.Context, d *schema.ResourceData, sqlExec sql.StatementExecutionInterface,
+	statement string) (*sql.StatementResponse, error) {
+	res, err := executeSqlStatement(ctx, sqlExec, s.request(statement))
+	if err != nil {
+		return nil, err
+	}
+	if !s.StoreResult {
+		return res, d.Set("result", nil)
+	}
+	rows, err := fetchSqlStatementRows(ctx, sqlExec, res, s.ResultRowLimit)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]any, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, row)
+	}
+	return res, d.Set("result", result)
+}
+
+// ResourceSqlStatement runs arbitrary SQL statements on a SQL warehouse when the resource is created,
+// updated or destroyed.
+func ResourceSqlStatement() common.Resource {
+	s := common.StructToSchema(SqlStatement{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		m["result"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+		}
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			// without an update statement there is nothing to run on change,
+			// so the resource is recreated to run the create statement again.
+			if d.Get("update_statement").(string) == "" {
+				for _, key := range []string{"parameter", "triggers"} {
+					if d.HasChange(key) {
+						if err := d.ForceNew(key); err != nil {
+							return err
+						}
+					}
+				}
+				return nil
+			}
+			if d.Get("store_result").(bool) && d.HasChanges("update_statement", "parameter", "triggers") {
+				return d.SetNewComputed("result")
+			}
+			return nil
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var stmt SqlStatement
+			common.DataToStructPointer(d, s, &stmt)
+			res, err := stmt.run(ctx, d, w.StatementExecution, stmt.CreateStatement)
+			if err != nil {
+				return err
+			}
+			d.SetId(res.StatementId)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// statements are not tracked on the backend, everything is kept in the state
+			return nil
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var stmt SqlStatement
+			common.DataToStructPointer(d, s, &stmt)
+			if stmt.UpdateStatement == "" || !d.HasChanges("update_statement", "parameter", "triggers") {
+				return nil
+			}
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			// keep previous statements and triggers in the state, if the statement fails,
+			// so that the next apply runs it again
+			d.Partial(true)
+			_, err = stmt.run(ctx, d, w.StatementExecution, stmt.UpdateStatement)
+			if err != nil {
+				return err
+			}
+			d.Partial(false)
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var stmt SqlStatement
+			common.DataToStructPointer(d, s, &stmt)
+			if stmt.DestroyStatement == "" {
+				return nil
+			}
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			stmt.StoreResult = false
+			_, err = executeSqlStatement(ctx, w.StatementExecution, stmt.request(stmt.DestroyStatement))
+			return err
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(sqlStatementDefaultTimeout),
+			Update: schema.DefaultTimeout(sqlStatementDefaultTimeout),
+			Delete: schema.DefaultTimeout(sqlStatementDefaultTimeout),
+		},
+	}
+}
@@ -0,0 +1,268 @@
+package catalog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSqlStatementCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceSqlStatement(),
+		qa.CornerCaseSkipCRUD("read"), qa.CornerCaseSkipCRUD("update"), qa.CornerCaseSkipCRUD("delete"))
+}
+
+func TestSqlStatementCreate(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockStatementExecutionAPI().EXPECT()
+			e.ExecuteStatement(mock.Anything, sql.ExecuteStatementRequest{
+				Statement:     "CREATE FUNCTION main.default.f(x INT) RETURNS INT RETURN x + :y",
+				WarehouseId:   "abc",
+				WaitTimeout:   "50s",
+				OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+				Parameters: []sql.StatementParameterListItem{
+					{Name: "y", Value: "1", Type: "INT"},
+				},
+			}).Return(&sql.StatementResponse{
+				StatementId: "s1",
+				Status:      &sql.StatementStatus{State: sql.StatementStateSucceeded},
+			}, nil)
+		},
+		Resource: ResourceSqlStatement(),
+		HCL: `
+		warehouse_id     = "abc"
+		create_statement = "CREATE FUNCTION main.default.f(x INT) RETURNS INT RETURN x + :y"
+		parameter {
+			name  = "y"
+			value = "1"
+			type  = "INT"
+		}`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "s1",
+		"result_row_limit": 1000,
+	})
+}
+
+func TestSqlStatementCreate_PollsAndStoresResult(t *testing.T) {
+	defer func(interval time.Duration) { sqlStatementPollInterval = interval }(sqlStatementPollInterval)
+	sqlStatementPollInterval = 0
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockStatementExecutionAPI().EXPECT()
+			e.ExecuteStatement(mock.Anything, sql.ExecuteStatementRequest{
+				Statement:     "SELECT id, name FROM main.default.tenants",
+				WarehouseId:   "abc",
+				WaitTimeout:   "50s",
+				OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+				Disposition:   sql.DispositionInline,
+				Format:        sql.FormatJsonArray,
+				RowLimit:      1000,
+			}).Return(&sql.StatementResponse{
+				StatementId: "s1",
+				Status:      &sql.StatementStatus{State: sql.StatementStateRunning},
+			}, nil)
+			e.GetStatementByStatementId(mock.Anything, "s1").Return(&sql.StatementResponse{
+				StatementId: "s1",
+				Status:      &sql.StatementStatus{State: sql.StatementStateSucceeded},
+				Manifest: &sql.ResultManifest{
+					Schema: &sql.ResultSchema{
+						Columns: []sql.ColumnInfo{{Name: "id"}, {Name: "name"}},
+					},
+				},
+				Result: &sql.ResultData{
+					DataArray:      [][]string{{"1", "a"}},
+					NextChunkIndex: 1,
+				},
+			}, nil)
+			e.GetStatementResultChunkNByStatementIdAndChunkIndex(mock.Anything, "s1", 1).Return(&sql.ResultData{
+				ChunkIndex: 1,
+				DataArray:  [][]string{{"2", "b"}},
+			}, nil)
+		},
+		Resource: ResourceSqlStatement(),
+		HCL: `
+		warehouse_id     = "abc"
+		create_statement = "SELECT id, name FROM main.default.tenants"
+		store_result     = true`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":            "s1",
+		"result.#":      2,
+		"result.0.id":   "1",
+		"result.0.name": "a",
+		"result.1.id":   "2",
+		"result.1.name": "b",
+	})
+}
+
+func TestSqlStatementCreate_Failed(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockStatementExecutionAPI().EXPECT().ExecuteStatement(mock.Anything, mock.Anything).Return(&sql.StatementResponse{
+				StatementId: "s1",
+				Status: &sql.StatementStatus{
+					State: sql.StatementStateFailed,
+					Error: &sql.ServiceError{Message: "[TABLE_OR_VIEW_NOT_FOUND] nope"},
+				},
+			}, nil)
+		},
+		Resource: ResourceSqlStatement(),
+		HCL: `
+		warehouse_id     = "abc"
+		create_statement = "SELECT * FROM nope"`,
+		Create: true,
+	}.ExpectError(t, "statement failed to execute: FAILED: [TABLE_OR_VIEW_NOT_FOUND] nope")
+}
+
+func TestSqlStatementUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockStatementExecutionAPI().EXPECT().ExecuteStatement(mock.Anything, sql.ExecuteStatementRequest{
+				Statement:     "ALTER MATERIALIZED VIEW main.default.mv SCHEDULE CRON '0 0 * * * ?'",
+				WarehouseId:   "abc",
+				WaitTimeout:   "50s",
+				OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+			}).Return(&sql.StatementResponse{
+				StatementId: "s2",
+				Status:      &sql.StatementStatus{State: sql.StatementStateSucceeded},
+			}, nil)
+		},
+		Resource: ResourceSqlStatement(),
+		ID:       "s1",
+		InstanceState: map[string]string{
+			"warehouse_id":     "abc",
+			"create_statement": "CREATE MATERIALIZED VIEW main.default.mv AS SELECT 1",
+			"update_statement": "ALTER MATERIALIZED VIEW main.default.mv SCHEDULE CRON '0 0 * * * ?'",
+			"triggers.%":       "1",
+			"triggers.version": "1",
+		},
+		HCL: `
+		warehouse_id     = "abc"
+		create_statement = "CREATE MATERIALIZED VIEW main.default.mv AS SELECT 1"
+		update_statement = "ALTER MATERIALIZED VIEW main.default.mv SCHEDULE CRON '0 0 * * * ?'"
+		triggers = {
+			version = "2"
+		}`,
+		Update: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":               "s1",
+		"triggers.version": "2",
+	})
+}
+
+func TestSqlStatementUpdate_FailedKeepsPreviousState(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockStatementExecutionAPI().EXPECT().ExecuteStatement(mock.Anything, mock.Anything).Return(&sql.StatementResponse{
+				StatementId: "s2",
+				Status: &sql.StatementStatus{
+					State: sql.StatementStateFailed,
+					Error: &sql.ServiceError{Message: "[PARSE_SYNTAX_ERROR] nope"},
+				},
+			}, nil)
+		},
+		Resource: ResourceSqlStatement(),
+		ID:       "s1",
+		InstanceState: map[string]string{
+			"warehouse_id":     "abc",
+			"create_statement": "CREATE MATERIALIZED VIEW main.default.mv AS SELECT 1",
+			"update_statement": "ALTER MATERIALIZED VIEW main.default.mv SCHEDULE CRON '0 0 * * * ?'",
+			"triggers.%":       "1",
+			"triggers.version": "1",
+		},
+		HCL: `
+		warehouse_id     = "abc"
+		create_statement = "CREATE MATERIALIZED VIEW main.default.mv AS SELECT 1"
+		update_statement = "ALTER MATERIALIZED VIEW main.default.mv SCHEDULE"
+		triggers = {
+			version = "2"
+		}`,
+		Update: true,
+	}.Apply(t)
+	assert.EqualError(t, err, "statement failed to execute: FAILED: [PARSE_SYNTAX_ERROR] nope")
+	state := d.State().Attributes
+	assert.Equal(t, "1", state["triggers.version"])
+	assert.Equal(t, "ALTER MATERIALIZED VIEW main.default.mv SCHEDULE CRON '0 0 * * * ?'", state["update_statement"])
+}
+
+func TestSqlStatementUpdate_ForceNewWithoutUpdateStatement(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSqlStatement(),
+		ID:       "s1",
+		InstanceState: map[string]string{
+			"warehouse_id":     "abc",
+			"create_statement": "CREATE MATERIALIZED VIEW main.default.mv AS SELECT 1",
+			"triggers.%":       "1",
+			"triggers.version": "1",
+		},
+		HCL: `
+		warehouse_id     = "abc"
+		create_statement = "CREATE MATERIALIZED VIEW main.default.mv AS SELECT 1"
+		triggers = {
+			version = "2"
+		}`,
+		Update: true,
+	}.ExpectError(t, "changes require new: triggers.version")
+}
+
+func TestSqlStatementUpdate_ForceNewOnSchemaChange(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSqlStatement(),
+		ID:       "s1",
+		InstanceState: map[string]string{
+			"warehouse_id":     "abc",
+			"create_statement": "CREATE MATERIALIZED VIEW mv AS SELECT 1",
+			"update_statement": "REFRESH MATERIALIZED VIEW mv",
+			"catalog":          "main",
+			"schema":           "default",
+		},
+		HCL: `
+		warehouse_id     = "abc"
+		create_statement = "CREATE MATERIALIZED VIEW mv AS SELECT 1"
+		update_statement = "REFRESH MATERIALIZED VIEW mv"
+		catalog          = "main"
+		schema           = "sales"`,
+		Update: true,
+	}.ExpectError(t, "changes require new: schema")
+}
+
+func TestSqlStatementDelete(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockStatementExecutionAPI().EXPECT().ExecuteStatement(mock.Anything, sql.ExecuteStatementRequest{
+				Statement:     "DROP FUNCTION main.default.f",
+				WarehouseId:   "abc",
+				WaitTimeout:   "50s",
+				OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+			}).Return(&sql.StatementResponse{
+				StatementId: "s3",
+				Status:      &sql.StatementStatus{State: sql.StatementStateSucceeded},
+			}, nil)
+		},
+		Resource: ResourceSqlStatement(),
+		ID:       "s1",
+		HCL: `
+		warehouse_id      = "abc"
+		create_statement  = "CREATE FUNCTION main.default.f() RETURNS INT RETURN 1"
+		destroy_statement = "DROP FUNCTION main.default.f"`,
+		Delete: true,
+	}.ApplyNoError(t)
+}
+
+func TestSqlStatementDelete_NoDestroyStatement(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {},
+		Resource:                ResourceSqlStatement(),
+		ID:                      "s1",
+		HCL: `
+		warehouse_id     = "abc"
+		create_statement = "SELECT 1"`,
+		Delete: true,
+	}.ApplyNoError(t)
+}
@@ -116,7 +116,7 @@ func (ti *SqlTableInfo) FullName() string {
 }
 
 func (ti *SqlTableInfo) SQLFullName() string {
-	return fmt.Sprintf("`%s`.`%s`.`%s`", ti.CatalogName, ti.SchemaName, ti.Name)
+	return fmt.Sprintf("%s.%s.%s", QuoteIdentifier(ti.CatalogName), QuoteIdentifier(ti.SchemaName), QuoteIdentifier(ti.Name))
 }
 
 func parseComment(s string) string {
@@ -508,19 +508,12 @@ func (ti *SqlTableInfo) applySql(sqlQuery string) error {
 	if ti.WarehouseID != "" {
 		execCtx, cancel := context.WithTimeout(context.Background(), time.Duration(MaxSqlExecWaitTimeout)*time.Second)
 		defer cancel()
-		sqlRes, err := ti.sqlExec.ExecuteStatement(execCtx, sql.ExecuteStatementRequest{
+		_, err := executeSqlStatement(execCtx, ti.sqlExec, sql.ExecuteStatementRequest{
 			Statement:     sqlQuery,
-			WaitTimeout:   fmt.Sprintf("%ds", MaxSqlExecWaitTimeout), //max allowed by sql exec
 			WarehouseId:   ti.WarehouseID,
 			OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutCancel,
 		})
-		if err != nil {
-			return err
-		}
-		if sqlRes.Status.State != "SUCCEEDED" {
-			return fmt.Errorf("statement failed to execute: %s", sqlRes.Status.State)
-		}
-		return nil
+		return err
 	}
 
 	r := ti.exec.Execute(ti.ClusterID, "sql", sqlQuery)
@@ -39,7 +39,8 @@ var storageCredentialSchema = common.StructToSchema(StorageCredentialInfo{},
 
 func ResourceStorageCredential() common.Resource {
 	return common.Resource{
-		Schema: storageCredentialSchema,
+		Schema:   storageCredentialSchema,
+		Identity: common.NewIdentity("name"),
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			metastoreId := d.Get("metastore_id").(string)
 
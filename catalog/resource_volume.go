@@ -61,7 +61,8 @@ func ResourceVolume() common.Resource {
 			return m
 		})
 	return common.Resource{
-		Schema: s,
+		Schema:   s,
+		Identity: common.NewSeparatedIdentity(".", "catalog_name", "schema_name", "name").Mutable(),
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
@@ -815,3 +815,7 @@ func TestVolumeDelete_Error(t *testing.T) {
 		ID:       "testCatalogName.testSchemaName.testName",
 	}.ExpectError(t, "Something went wrong")
 }
+
+func TestVolumeIdentityChangesOnRename(t *testing.T) {
+	assert.True(t, ResourceVolume().ToResource().ResourceBehavior.MutableIdentity)
+}
@@ -0,0 +1,115 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/service/sql"
+)
+
+// sqlStatementPollInterval is the delay between status checks of a statement that is still running
+// after the synchronous wait timeout has elapsed.
+var sqlStatementPollInterval = 5 * time.Second
+
+// executeSqlStatement runs a statement on a SQL warehouse and waits for it to reach a terminal state.
+//
+// The request waits synchronously for up to MaxSqlExecWaitTimeout seconds (the maximum allowed by the
+// Statement Execution API). If the request asks to continue on wait timeout, the statement is polled
+// until it finishes or the context is done, in which case the statement is cancelled. An error is
+// returned for any statement that didn't succeed.
+func executeSqlStatement(ctx context.Context, sqlExec sql.StatementExecutionInterface,
+	req sql.ExecuteStatementRequest) (*sql.StatementResponse, error) {
+	if req.WaitTimeout == "" {
+		req.WaitTimeout = fmt.Sprintf("%ds", MaxSqlExecWaitTimeout) //max allowed by sql exec
+	}
+	if req.OnWaitTimeout == "" {
+		req.OnWaitTimeout = sql.ExecuteStatementRequestOnWaitTimeoutCancel
+	}
+	res, err := sqlExec.ExecuteStatement(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for isSqlStatementRunning(res) {
+		log.Printf("[DEBUG] Statement %s is %s, waiting", res.StatementId, res.Status.State)
+		select {
+		case <-ctx.Done():
+			// use a fresh context, as the original one is already cancelled
+			cancelErr := sqlExec.CancelExecution(context.Background(), sql.CancelExecutionRequest{
+				StatementId: res.StatementId,
+			})
+			if cancelErr != nil {
+				log.Printf("[WARN] Can't cancel statement %s: %s", res.StatementId, cancelErr)
+			}
+			return nil, fmt.Errorf("statement %s didn't finish in time: %w", res.StatementId, ctx.Err())
+		case <-time.After(sqlStatementPollInterval):
+		}
+		res, err = sqlExec.GetStatementByStatementId(ctx, res.StatementId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if res.Status == nil || res.Status.State != sql.StatementStateSucceeded {
+		return nil, sqlStatementError(res)
+	}
+	return res, nil
+}
+
+func isSqlStatementRunning(res *sql.StatementResponse) bool {
+	if res.Status == nil {
+		return false
+	}
+	return res.Status.State == sql.StatementStatePending || res.Status.State == sql.StatementStateRunning
+}
+
+func sqlStatementError(res *sql.StatementResponse) error {
+	if res.Status == nil {
+		return fmt.Errorf("statement failed to execute: no status returned")
+	}
+	if res.Status.Error != nil && res.Status.Error.Message != "" {
+		return fmt.Errorf("statement failed to execute: %s: %s", res.Status.State, res.Status.Error.Message)
+	}
+	return fmt.Errorf("statement failed to execute: %s", res.Status.State)
+}
+
+// sqlStatementColumns returns the column descriptions from the statement result manifest.
+func sqlStatementColumns(res *sql.StatementResponse) []sql.ColumnInfo {
+	if res.Manifest == nil || res.Manifest.Schema == nil {
+		return nil
+	}
+	return res.Manifest.Schema.Columns
+}
+
+// fetchSqlStatementRows reads all inline result chunks of a succeeded statement in the JSON_ARRAY format,
+// and converts them to a list of column name to value maps. At most rowLimit rows are returned if
+// rowLimit is positive.
+func fetchSqlStatementRows(ctx context.Context, sqlExec sql.StatementExecutionInterface,
+	res *sql.StatementResponse, rowLimit int) ([]map[string]string, error) {
+	columns := sqlStatementColumns(res)
+	rows := []map[string]string{}
+	chunk := res.Result
+	for chunk != nil {
+		for _, values := range chunk.DataArray {
+			if rowLimit > 0 && len(rows) >= rowLimit {
+				return rows, nil
+			}
+			row := make(map[string]string, len(columns))
+			for i, column := range columns {
+				if i < len(values) {
+					row[column.Name] = values[i]
+				}
+			}
+			rows = append(rows, row)
+		}
+		if chunk.NextChunkIndex == 0 {
+			break
+		}
+		next, err := sqlExec.GetStatementResultChunkNByStatementIdAndChunkIndex(ctx, res.StatementId, chunk.NextChunkIndex)
+		if err != nil {
+			return nil, err
+		}
+		chunk = next
+	}
+	return rows, nil
+}
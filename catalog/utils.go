@@ -3,6 +3,7 @@ package catalog
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -57,3 +58,48 @@ func updateRequired(d *schema.ResourceData, updateOnly []string) bool {
 	}
 	return false
 }
+
+// SplitFullName splits the full name of a Unity Catalog object, like `catalog.schema.table`, into its parts.
+// Parts that contain dots have to be quoted with backticks, with backticks inside them doubled.
+func SplitFullName(fullName string) ([]string, error) {
+	var parts []string
+	var part strings.Builder
+	quoted, wasQuoted := false, false
+	for i := 0; i < len(fullName); i++ {
+		c := fullName[i]
+		switch {
+		case quoted && c == '`' && i+1 < len(fullName) && fullName[i+1] == '`':
+			part.WriteByte(c)
+			i++
+		case quoted && c == '`':
+			quoted = false
+		case quoted:
+			part.WriteByte(c)
+		case c == '`' && part.Len() == 0 && !wasQuoted:
+			quoted, wasQuoted = true, true
+		case c == '.':
+			if part.Len() == 0 {
+				return nil, fmt.Errorf("invalid full name %q: empty part", fullName)
+			}
+			parts = append(parts, part.String())
+			part.Reset()
+			wasQuoted = false
+		case wasQuoted:
+			return nil, fmt.Errorf("invalid full name %q: unexpected character after closing backtick", fullName)
+		default:
+			part.WriteByte(c)
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("invalid full name %q: missing closing backtick", fullName)
+	}
+	if part.Len() == 0 {
+		return nil, fmt.Errorf("invalid full name %q: empty part", fullName)
+	}
+	return append(parts, part.String()), nil
+}
+
+// QuoteIdentifier quotes the name with backticks, so it could be used in SQL statements
+func QuoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
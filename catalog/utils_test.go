@@ -0,0 +1,38 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitFullName(t *testing.T) {
+	for name, expected := range map[string][]string{
+		"main":                      {"main"},
+		"main.default.table":        {"main", "default", "table"},
+		"`my.catalog`.default.`t`":  {"my.catalog", "default", "t"},
+		"main.`with``backtick`.tbl": {"main", "with`backtick", "tbl"},
+	} {
+		parts, err := SplitFullName(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, expected, parts, name)
+	}
+}
+
+func TestSplitFullName_Errors(t *testing.T) {
+	for name, expected := range map[string]string{
+		"":                `invalid full name "": empty part`,
+		"main.":           `invalid full name "main.": empty part`,
+		"`main":           "invalid full name \"`main\": missing closing backtick",
+		"`main`x.default": "invalid full name \"`main`x.default\": unexpected character after closing backtick",
+	} {
+		_, err := SplitFullName(name)
+		assert.EqualError(t, err, expected, name)
+	}
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	assert.Equal(t, "`main`", QuoteIdentifier("main"))
+	assert.Equal(t, "`with``backtick`", QuoteIdentifier("with`backtick"))
+}
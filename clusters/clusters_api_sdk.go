@@ -2,7 +2,10 @@ package clusters
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/databricks/databricks-sdk-go"
@@ -44,3 +47,44 @@ func LatestSparkVersionOrDefault(ctx context.Context, w *databricks.WorkspaceCli
 	}
 	return version
 }
+
+var sparkVersionRegex = regexp.MustCompile(`^(\d+)\.(\d+)(\.(x|\d+))?(-|$)`)
+
+// CompareSparkVersions compares major and minor DBR versions of Spark version keys, like `15.4.x-scala2.12`,
+// and returns -1, 0 or 1 the same way as `strings.Compare` does
+func CompareSparkVersions(a, b string) (int, error) {
+	va, err := parseSparkVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := parseSparkVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	for i := range va {
+		if va[i] < vb[i] {
+			return -1, nil
+		}
+		if va[i] > vb[i] {
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func parseSparkVersion(v string) ([2]int, error) {
+	m := sparkVersionRegex.FindStringSubmatch(v)
+	if m == nil {
+		return [2]int{}, fmt.Errorf("invalid Spark version %q: expected format like 15.4.x-scala2.12", v)
+	}
+	// the regex guarantees digits, so errors are only possible on overflow
+	major, err := strconv.Atoi(m[1])
+	if err != nil {
+		return [2]int{}, fmt.Errorf("invalid Spark version %q: %w", v, err)
+	}
+	minor, err := strconv.Atoi(m[2])
+	if err != nil {
+		return [2]int{}, fmt.Errorf("invalid Spark version %q: %w", v, err)
+	}
+	return [2]int{major, minor}, nil
+}
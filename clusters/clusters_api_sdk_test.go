@@ -162,3 +162,26 @@ func TestStartClusterAndGetInfo_StartingError(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "I am a teapot")
 }
+
+func TestCompareSparkVersions(t *testing.T) {
+	for _, tc := range []struct {
+		a, b     string
+		expected int
+	}{
+		{"15.4.x-scala2.12", "16.2.x-scala2.12", -1},
+		{"15.4.x-scala2.12", "15.4.x-photon-scala2.12", 0},
+		{"15.10.x-scala2.12", "15.4", 1},
+		{"16.0", "16.0.x-gpu-ml-scala2.12", 0},
+	} {
+		result, err := CompareSparkVersions(tc.a, tc.b)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, result, "%s vs %s", tc.a, tc.b)
+	}
+}
+
+func TestCompareSparkVersions_Invalid(t *testing.T) {
+	_, err := CompareSparkVersions("15.4.x-scala2.12", "latest")
+	assert.EqualError(t, err, `invalid Spark version "latest": expected format like 15.4.x-scala2.12`)
+	_, err = CompareSparkVersions("15-scala2.12", "15.4")
+	assert.EqualError(t, err, `invalid Spark version "15-scala2.12": expected format like 15.4.x-scala2.12`)
+}
@@ -0,0 +1,138 @@
+package clusters
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var clusterCommandLanguages = []string{"python", "scala", "sql", "r"}
+
+type clusterCommandData struct {
+	ClusterID  string   `json:"cluster_id"`
+	Language   string   `json:"language"`
+	Command    string   `json:"command"`
+	ResultType string   `json:"result_type,omitempty" tf:"computed"`
+	Result     string   `json:"result,omitempty" tf:"computed"`
+	Columns    []string `json:"columns,omitempty" tf:"computed"`
+	Truncated  bool     `json:"truncated,omitempty" tf:"computed"`
+}
+
+// clusterCommandId returns the identifier of the given command on the cluster
+func clusterCommandId(clusterID, language, command string) string {
+	hash := sha256.Sum256([]byte(language + "\n" + command))
+	return fmt.Sprintf("%s/%x", clusterID, hash[:8])
+}
+
+// runClusterCommand executes the command on a running cluster and returns an error if it failed.
+// Commands of users run in a new execution context, so that their state isn't shared.
+func runClusterCommand(ctx context.Context, c *common.DatabricksClient,
+	clusterID, language, command string) (common.CommandResults, error) {
+	ctx = common.WithoutCommandContextReuse(ctx)
+	result := c.CommandExecutor(ctx).Execute(clusterID, language, command)
+	if result.Failed() {
+		return result, fmt.Errorf("command failed: %w", result.Err())
+	}
+	return result, nil
+}
+
+// commandResultColumns returns column names of table results
+func commandResultColumns(result common.CommandResults) []string {
+	columns := []string{}
+	fields, ok := result.Schema.([]any)
+	if !ok {
+		return columns
+	}
+	for _, field := range fields {
+		name := ""
+		if f, ok := field.(map[string]any); ok {
+			name, _ = f["name"].(string)
+		}
+		columns = append(columns, name)
+	}
+	return columns
+}
+
+// commandResultRows converts table results into rows keyed by column names. Values are
+// rendered as strings and columns with null values are omitted from a row.
+func commandResultRows(result common.CommandResults, columns []string) ([]any, error) {
+	rows := []any{}
+	data, ok := result.Data.([]any)
+	if !ok {
+		return rows, nil
+	}
+	for _, r := range data {
+		values, ok := r.([]any)
+		if !ok {
+			return nil, fmt.Errorf("unexpected row: %v", r)
+		}
+		row := map[string]any{}
+		for i, value := range values {
+			if value == nil || i >= len(columns) {
+				continue
+			}
+			switch v := value.(type) {
+			case string:
+				row[columns[i]] = v
+			default:
+				raw, err := json.Marshal(v)
+				if err != nil {
+					return nil, err
+				}
+				row[columns[i]] = string(raw)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// DataSourceClusterCommand runs a command on a running cluster and returns its results
+func DataSourceClusterCommand() common.Resource {
+	s := common.StructToSchema(clusterCommandData{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		m["language"].ValidateFunc = validation.StringInSlice(clusterCommandLanguages, false)
+		m["rows"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+		}
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var data clusterCommandData
+			common.DataToStructPointer(d, s, &data)
+			result, err := runClusterCommand(ctx, c, data.ClusterID, data.Language, data.Command)
+			if err != nil {
+				return err
+			}
+			data.ResultType = result.ResultType
+			data.Truncated = result.Truncated
+			rows := []any{}
+			switch result.ResultType {
+			case "text":
+				data.Result = result.Text()
+			case "table":
+				data.Columns = commandResultColumns(result)
+				rows, err = commandResultRows(result, data.Columns)
+				if err != nil {
+					return err
+				}
+			}
+			if err = common.StructToData(data, s, d); err != nil {
+				return err
+			}
+			d.SetId(clusterCommandId(data.ClusterID, data.Language, data.Command))
+			return d.Set("rows", rows)
+		},
+	}
+}
@@ -0,0 +1,92 @@
+package clusters
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceClusterCommand_Text(t *testing.T) {
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			assert.Equal(t, `print(spark.conf.get("spark.sql.shuffle.partitions"))`, commandStr)
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "200",
+			}
+		},
+		Resource: DataSourceClusterCommand(),
+		HCL: `
+		cluster_id = "abc"
+		language   = "python"
+		command    = "print(spark.conf.get(\"spark.sql.shuffle.partitions\"))"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":          clusterCommandId("abc", "python", `print(spark.conf.get("spark.sql.shuffle.partitions"))`),
+		"result_type": "text",
+		"result":      "200",
+		"rows.#":      0,
+	})
+}
+
+func TestDataSourceClusterCommand_Table(t *testing.T) {
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			return common.CommandResults{
+				ResultType: "table",
+				Schema: []any{
+					map[string]any{"name": "key", "type": `"string"`},
+					map[string]any{"name": "value", "type": `"string"`},
+					map[string]any{"name": "size", "type": `"long"`},
+				},
+				Data: []any{
+					[]any{"fs.azure.account.auth.type", "OAuth", 1},
+					[]any{"fs.s3a.endpoint", nil, 2.5},
+				},
+				Truncated: true,
+			}
+		},
+		Resource: DataSourceClusterCommand(),
+		HCL: `
+		cluster_id = "abc"
+		language   = "sql"
+		command    = "SET"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"result_type":  "table",
+		"columns.#":    3,
+		"columns.1":    "value",
+		"rows.#":       2,
+		"rows.0.key":   "fs.azure.account.auth.type",
+		"rows.0.value": "OAuth",
+		"rows.0.size":  "1",
+		"rows.1.key":   "fs.s3a.endpoint",
+		"rows.1.size":  "2.5",
+		"truncated":    true,
+	})
+}
+
+func TestDataSourceClusterCommand_Error(t *testing.T) {
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			return common.CommandResults{
+				ResultType: "error",
+				Summary:    "NameError: name 'foo' is not defined",
+			}
+		},
+		Resource: DataSourceClusterCommand(),
+		HCL: `
+		cluster_id = "abc"
+		language   = "python"
+		command    = "foo"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "command failed: NameError: name 'foo' is not defined")
+}
@@ -37,6 +37,7 @@ func ResourceCluster() common.Resource {
 		Delete:        resourceClusterDelete,
 		Schema:        clusterSchema,
 		SchemaVersion: clusterSchemaVersion,
+		Identity:      common.NewIdentity("cluster_id"),
 		Timeouts:      resourceClusterTimeouts(),
 		StateUpgraders: []schema.StateUpgrader{
 			{
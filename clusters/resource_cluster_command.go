@@ -0,0 +1,62 @@
+package clusters
+
+import (
+	"context"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type ClusterCommand struct {
+	ClusterID      string            `json:"cluster_id" tf:"force_new"`
+	Language       string            `json:"language" tf:"force_new"`
+	CreateCommand  string            `json:"create_command" tf:"force_new"`
+	DestroyCommand string            `json:"destroy_command,omitempty"`
+	Triggers       map[string]string `json:"triggers,omitempty" tf:"force_new"`
+	ResultType     string            `json:"result_type,omitempty" tf:"computed"`
+	Result         string            `json:"result,omitempty" tf:"computed"`
+}
+
+// ResourceClusterCommand runs commands on a running cluster when the resource is created or destroyed
+func ResourceClusterCommand() common.Resource {
+	s := common.StructToSchema(ClusterCommand{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		m["language"].ValidateFunc = validation.StringInSlice(clusterCommandLanguages, false)
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var cmd ClusterCommand
+			common.DataToStructPointer(d, s, &cmd)
+			result, err := runClusterCommand(ctx, c, cmd.ClusterID, cmd.Language, cmd.CreateCommand)
+			if err != nil {
+				return err
+			}
+			cmd.ResultType = result.ResultType
+			cmd.Result = result.Text()
+			if err = common.StructToData(cmd, s, d); err != nil {
+				return err
+			}
+			d.SetId(clusterCommandId(cmd.ClusterID, cmd.Language, cmd.CreateCommand))
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// commands are not tracked on the backend, everything is kept in the state
+			return nil
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// only destroy_command can change in place, and it's used on destroy
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var cmd ClusterCommand
+			common.DataToStructPointer(d, s, &cmd)
+			if cmd.DestroyCommand == "" {
+				return nil
+			}
+			_, err := runClusterCommand(ctx, c, cmd.ClusterID, cmd.Language, cmd.DestroyCommand)
+			return err
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package clusters
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceClusterCommandCreate(t *testing.T) {
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			assert.Equal(t, `dbutils.fs.mkdirs("/bootstrap")`, commandStr)
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "Out[1]: True",
+			}
+		},
+		Resource: ResourceClusterCommand(),
+		HCL: `
+		cluster_id      = "abc"
+		language        = "python"
+		create_command  = "dbutils.fs.mkdirs(\"/bootstrap\")"
+		destroy_command = "dbutils.fs.rm(\"/bootstrap\", True)"`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":          clusterCommandId("abc", "python", `dbutils.fs.mkdirs("/bootstrap")`),
+		"result_type": "text",
+		"result":      "True",
+	})
+}
+
+func TestResourceClusterCommandCreate_Error(t *testing.T) {
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			return common.CommandResults{
+				ResultType: "error",
+				Summary:    "Cluster abc has to be running or resizing, but is TERMINATED",
+			}
+		},
+		Resource: ResourceClusterCommand(),
+		HCL: `
+		cluster_id     = "abc"
+		language       = "scala"
+		create_command = "println(1)"`,
+		Create: true,
+	}.ExpectError(t, "command failed: Cluster abc has to be running or resizing, but is TERMINATED")
+}
+
+func TestResourceClusterCommandDelete(t *testing.T) {
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			assert.Equal(t, `dbutils.fs.rm("/bootstrap", True)`, commandStr)
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "Out[1]: True",
+			}
+		},
+		Resource: ResourceClusterCommand(),
+		HCL: `
+		cluster_id      = "abc"
+		language        = "python"
+		create_command  = "dbutils.fs.mkdirs(\"/bootstrap\")"
+		destroy_command = "dbutils.fs.rm(\"/bootstrap\", True)"`,
+		Delete: true,
+		ID:     "abc/123",
+	}.ApplyNoError(t)
+}
+
+func TestResourceClusterCommandDelete_NoDestroyCommand(t *testing.T) {
+	qa.ResourceFixture{
+		CommandMock: func(commandStr string) common.CommandResults {
+			t.Fatalf("unexpected command: %s", commandStr)
+			return common.CommandResults{}
+		},
+		Resource: ResourceClusterCommand(),
+		HCL: `
+		cluster_id     = "abc"
+		language       = "python"
+		create_command = "print(1)"`,
+		Delete: true,
+		ID:     "abc/123",
+	}.ApplyNoError(t)
+}
@@ -34,17 +34,15 @@ type CommandsAPI struct {
 	context context.Context
 }
 
-// Execute creates a spark context and executes a command and then closes context
-// Any leading whitespace is trimmed
+// Execute runs a command in an execution context, that is reused by subsequent commands of
+// the same resource type for the same cluster and language, unless the context of the API
+// is created with [common.WithoutCommandContextReuse]. Any leading whitespace is trimmed
 func (a CommandsAPI) Execute(clusterID, language, commandStr string) common.CommandResults {
 	// this is the place, where API version propagation through context looks strange
 	ctx := context.WithValue(a.context, common.Api, common.API_2_0)
 	cluster, err := clusters.NewClustersAPI(ctx, a.client).Get(clusterID)
 	if err != nil {
-		return common.CommandResults{
-			ResultType: "error",
-			Summary:    err.Error(),
-		}
+		return errorResults(err)
 	}
 	if !cluster.IsRunningOrResizing() {
 		return common.CommandResults{
@@ -54,48 +52,42 @@ func (a CommandsAPI) Execute(clusterID, language, commandStr string) common.Comm
 	}
 	commandStr = TrimLeadingWhitespace(commandStr)
 	log.Printf("[INFO] Executing %s command on %s:\n%s", language, clusterID, commandStr)
-	context, err := a.createContext(language, clusterID)
+	pool := a.client.CommandContexts()
+	owner := common.CommandContextOwner(a.context)
+	commandContext, reused, err := a.acquireContext(pool, owner, clusterID, language)
 	if err != nil {
-		return common.CommandResults{
-			ResultType: "error",
-			Summary:    err.Error(),
-		}
+		return errorResults(err)
 	}
-	err = a.waitForContextReady(context, clusterID)
-	if err != nil {
-		return common.CommandResults{
-			ResultType: "error",
-			Summary:    err.Error(),
+	commandID, err := a.createCommand(commandContext.ID, clusterID, language, commandStr)
+	if err != nil && reused {
+		log.Printf("[INFO] Cannot reuse execution context %s on %s: %s", commandContext.ID, clusterID, err)
+		a.destroyContext(commandContext)
+		commandContext, err = a.newContext(owner, clusterID, language)
+		if err != nil {
+			return errorResults(err)
 		}
+		commandID, err = a.createCommand(commandContext.ID, clusterID, language, commandStr)
 	}
-	commandID, err := a.createCommand(context, clusterID, language, commandStr)
 	if err != nil {
-		return common.CommandResults{
-			ResultType: "error",
-			Summary:    err.Error(),
-		}
+		a.destroyContext(commandContext)
+		return errorResults(err)
 	}
 	// TODO: merge getCommand and waitForCommandFinished to "waitForCommandResults"
-	err = a.waitForCommandFinished(commandID, context, clusterID)
+	err = a.waitForCommandFinished(commandID, commandContext.ID, clusterID)
 	if err != nil {
-		return common.CommandResults{
-			ResultType: "error",
-			Summary:    err.Error(),
-		}
+		// the context may still be busy with the command, so it's not reused
+		a.destroyContext(commandContext)
+		return errorResults(err)
 	}
-	command, err := a.getCommand(commandID, context, clusterID)
+	command, err := a.getCommand(commandID, commandContext.ID, clusterID)
 	if err != nil {
-		return common.CommandResults{
-			ResultType: "error",
-			Summary:    err.Error(),
-		}
+		a.destroyContext(commandContext)
+		return errorResults(err)
 	}
-	err = a.deleteContext(context, clusterID)
-	if err != nil {
-		return common.CommandResults{
-			ResultType: "error",
-			Summary:    err.Error(),
-		}
+	if owner == "" {
+		a.destroyContext(commandContext)
+	} else {
+		pool.Release(commandContext)
 	}
 	if command.Results == nil {
 		log.Printf("[ERROR] Command has no results: %#v", command)
@@ -107,6 +99,62 @@ func (a CommandsAPI) Execute(clusterID, language, commandStr string) common.Comm
 	return *command.Results
 }
 
+func errorResults(err error) common.CommandResults {
+	return common.CommandResults{
+		ResultType: "error",
+		Summary:    err.Error(),
+	}
+}
+
+// acquireContext returns an idle context of the owner from the pool, that is still running on the
+// cluster, or creates a new one. Contexts expire, when cluster restarts or they are idle for too long.
+// Contexts without an owner are never released to the pool, so there are none to acquire.
+func (a CommandsAPI) acquireContext(pool *common.CommandContextPool,
+	owner, clusterID, language string) (*common.CommandContext, bool, error) {
+	for commandContext := pool.Acquire(owner, clusterID, language); commandContext != nil; commandContext = pool.Acquire(owner, clusterID, language) {
+		status, err := a.getContext(commandContext.ID, clusterID)
+		if err == nil && status == "Running" {
+			log.Printf("[DEBUG] Reusing execution context %s on %s", commandContext.ID, clusterID)
+			return commandContext, true, nil
+		}
+		log.Printf("[INFO] Execution context %s on %s is no longer usable: %s %v", commandContext.ID, clusterID, status, err)
+		a.destroyContext(commandContext)
+	}
+	commandContext, err := a.newContext(owner, clusterID, language)
+	return commandContext, false, err
+}
+
+// newContext creates an execution context and waits until it's ready
+func (a CommandsAPI) newContext(owner, clusterID, language string) (*common.CommandContext, error) {
+	pool := a.client.CommandContexts()
+	err := pool.Reserve(a.context, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	contextID, err := a.createContext(language, clusterID)
+	if err != nil {
+		pool.Unreserve(clusterID)
+		return nil, err
+	}
+	commandContext := common.NewCommandContext(contextID, owner, clusterID, language, func(ctx context.Context) error {
+		return a.client.Post(context.WithValue(ctx, common.Api, common.API_1_2), "/contexts/destroy", genericCommandRequest{
+			ContextID: contextID,
+			ClusterID: clusterID,
+		}, nil)
+	})
+	err = a.waitForContextReady(contextID, clusterID)
+	if err != nil {
+		a.destroyContext(commandContext)
+		return nil, err
+	}
+	return commandContext, nil
+}
+
+// destroyContext removes the context from the cluster on the best effort basis
+func (a CommandsAPI) destroyContext(commandContext *common.CommandContext) {
+	a.client.CommandContexts().Discard(a.context, commandContext)
+}
+
 type genericCommandRequest struct {
 	CommandID string `json:"commandId,omitempty" url:"commandId,omitempty"`
 	Language  string `json:"language,omitempty" url:"language,omitempty"`
@@ -136,13 +184,6 @@ func (a CommandsAPI) getCommand(commandID, contextID, clusterID string) (Command
 	return commandResp, err
 }
 
-func (a CommandsAPI) deleteContext(contextID, clusterID string) error {
-	return a.client.Post(a.context, "/contexts/destroy", genericCommandRequest{
-		ContextID: contextID,
-		ClusterID: clusterID,
-	}, nil)
-}
-
 func (a CommandsAPI) getContext(contextID, clusterID string) (string, error) {
 	var contextStatus Command // internal hack, yes
 	err := a.client.Get(a.context, "/contexts/status", genericCommandRequest{
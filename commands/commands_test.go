@@ -270,6 +270,14 @@ func TestCommandsAPIExecute_FailToWaitForContext(t *testing.T) {
 				Message: "Does not compute",
 			},
 		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "abc",
+			},
+		},
 	}, func(ctx context.Context, client *common.DatabricksClient) {
 		commands := NewCommandsAPI(ctx, client)
 		cr := commands.Execute("abc", "cobol", "Hello?")
@@ -308,6 +316,14 @@ func TestCommandsAPIExecute_FailToCreateCommand(t *testing.T) {
 				Message: "Does not compute",
 			},
 		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "abc",
+			},
+		},
 	}, func(ctx context.Context, client *common.DatabricksClient) {
 		commands := NewCommandsAPI(ctx, client)
 		cr := commands.Execute("abc", "cobol", "Hello?")
@@ -353,6 +369,14 @@ func TestCommandsAPIExecute_FailToWaitForCommand(t *testing.T) {
 				Message: "Does not compute",
 			},
 		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "abc",
+			},
+		},
 	}, func(ctx context.Context, client *common.DatabricksClient) {
 		commands := NewCommandsAPI(ctx, client)
 		cr := commands.Execute("abc", "cobol", "Hello?")
@@ -405,6 +429,14 @@ func TestCommandsAPIExecute_FailToGetCommand(t *testing.T) {
 				Message: "Does not compute",
 			},
 		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "abc",
+			},
+		},
 	}, func(ctx context.Context, client *common.DatabricksClient) {
 		commands := NewCommandsAPI(ctx, client)
 		cr := commands.Execute("abc", "cobol", "Hello?")
@@ -412,7 +444,7 @@ func TestCommandsAPIExecute_FailToGetCommand(t *testing.T) {
 	})
 }
 
-func TestCommandsAPIExecute_FailToDeleteContext(t *testing.T) {
+func TestCommandsAPIExecute_FailToDeleteContextOnClose(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
 			Method:   "GET",
@@ -448,6 +480,10 @@ func TestCommandsAPIExecute_FailToDeleteContext(t *testing.T) {
 			Resource:     "/api/1.2/commands/status?clusterId=abc&commandId=abc&contextId=abc",
 			Response: Command{
 				Status: "Finished",
+				Results: &common.CommandResults{
+					ResultType: "text",
+					Data:       "done",
+				},
 			},
 		},
 		{
@@ -461,7 +497,11 @@ func TestCommandsAPIExecute_FailToDeleteContext(t *testing.T) {
 	}, func(ctx context.Context, client *common.DatabricksClient) {
 		commands := NewCommandsAPI(ctx, client)
 		cr := commands.Execute("abc", "cobol", "Hello?")
-		assert.EqualError(t, cr.Err(), "Does not compute")
+		require.NoError(t, cr.Err())
+		assert.Equal(t, 1, client.CommandContexts().Len())
+		// failure to destroy a context on close is only logged
+		client.CommandContexts().Close(ctx)
+		assert.Equal(t, 0, client.CommandContexts().Len())
 	})
 }
 
@@ -513,3 +553,206 @@ func TestCommandsAPIExecute_NoCommandResults(t *testing.T) {
 		assert.EqualError(t, cr.Err(), "Command has no results")
 	})
 }
+
+func TestCommandsAPIExecute_ReusesContext(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+			Response: clusters.ClusterInfo{
+				State: "RUNNING",
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/create",
+			Response: Command{
+				ID: "ctx1",
+			},
+		},
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/1.2/contexts/status?clusterId=abc&contextId=ctx1",
+			Response: Command{
+				Status: "Running",
+			},
+		},
+		{
+			Method:       "POST",
+			ReuseRequest: true,
+			Resource:     "/api/1.2/commands/execute",
+			Response: Command{
+				ID: "cmd",
+			},
+		},
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/1.2/commands/status?clusterId=abc&commandId=cmd&contextId=ctx1",
+			Response: Command{
+				Status: "Finished",
+				Results: &common.CommandResults{
+					ResultType: "text",
+					Data:       "done",
+				},
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "ctx1",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		commands := NewCommandsAPI(ctx, client)
+		for i := 0; i < 3; i++ {
+			cr := commands.Execute("abc", "python", "print('done')")
+			require.NoError(t, cr.Err())
+			assert.Equal(t, "done", cr.Text())
+		}
+		// contexts/create fixture is not reusable, so the single context was used by all commands
+		assert.Equal(t, 1, client.CommandContexts().Len())
+		client.CommandContexts().Close(ctx)
+		assert.Equal(t, 0, client.CommandContexts().Len())
+	})
+}
+
+func TestCommandsAPIExecute_RecreatesExpiredContext(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+			Response: clusters.ClusterInfo{
+				State: "RUNNING",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/1.2/contexts/status?clusterId=abc&contextId=expired",
+			Status:   400,
+			Response: apierr.APIError{
+				Message: "ContextNotFound",
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "expired",
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/create",
+			Response: Command{
+				ID: "fresh",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/1.2/contexts/status?clusterId=abc&contextId=fresh",
+			Response: Command{
+				Status: "Running",
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/commands/execute",
+			ExpectedRequest: genericCommandRequest{
+				Language:  "python",
+				ClusterID: "abc",
+				ContextID: "fresh",
+				Command:   "print('done')\n",
+			},
+			Response: Command{
+				ID: "cmd",
+			},
+		},
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/1.2/commands/status?clusterId=abc&commandId=cmd&contextId=fresh",
+			Response: Command{
+				Status: "Finished",
+				Results: &common.CommandResults{
+					ResultType: "text",
+					Data:       "done",
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		client.CommandContexts().Release(common.NewCommandContext("expired", "unknown", "abc", "python", nil))
+		commands := NewCommandsAPI(ctx, client)
+		cr := commands.Execute("abc", "python", "print('done')")
+		require.NoError(t, cr.Err())
+		assert.Equal(t, "done", cr.Text())
+		cc := client.CommandContexts().Acquire("unknown", "abc", "python")
+		require.NotNil(t, cc)
+		assert.Equal(t, "fresh", cc.ID)
+	})
+}
+
+func TestCommandsAPIExecute_WithoutContextReuse(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/clusters/get?cluster_id=abc",
+			Response: clusters.ClusterInfo{
+				State: "RUNNING",
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/create",
+			Response: Command{
+				ID: "ctx1",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/1.2/contexts/status?clusterId=abc&contextId=ctx1",
+			Response: Command{
+				Status: "Running",
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/commands/execute",
+			Response: Command{
+				ID: "cmd",
+			},
+		},
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/1.2/commands/status?clusterId=abc&commandId=cmd&contextId=ctx1",
+			Response: Command{
+				Status: "Finished",
+				Results: &common.CommandResults{
+					ResultType: "text",
+					Data:       "done",
+				},
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "ctx1",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		commands := NewCommandsAPI(common.WithoutCommandContextReuse(ctx), client)
+		cr := commands.Execute("abc", "python", "print('done')")
+		require.NoError(t, cr.Err())
+		assert.Equal(t, "done", cr.Text())
+		assert.Equal(t, 0, client.CommandContexts().Len())
+	})
+}
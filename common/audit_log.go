@@ -0,0 +1,327 @@
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/config"
+)
+
+const redactedValue = "**REDACTED**"
+
+// sensitiveFields are substrings of JSON keys, which values are redacted even if request bodies are logged
+var sensitiveFields = []string{"password", "secret", "token", "string_value", "bytes_value", "private_key", "credential"}
+
+// AuditRecord is a single line of the audit log, describing one mutating API call
+type AuditRecord struct {
+	Timestamp    time.Time `json:"timestamp"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	ResourceID   string    `json:"resource_id,omitempty"`
+	Operation    string    `json:"operation,omitempty"`
+	Method       string    `json:"method"`
+	Path         string    `json:"path"`
+	Status       int       `json:"status,omitempty"`
+	RequestID    string    `json:"request_id,omitempty"`
+	DurationMs   int64     `json:"duration_ms"`
+	Error        string    `json:"error,omitempty"`
+	RequestBody  any       `json:"request_body,omitempty"`
+	// PrevHash is the hash of the previous record in the file, empty for the first one
+	PrevHash string `json:"prev_hash"`
+	// Hash is the hex-encoded HMAC-SHA256 of the JSON of this record without the hash field,
+	// or its plain SHA-256 if the log has no key
+	Hash string `json:"hash,omitempty"`
+}
+
+// seal sets the hash of the record, chaining it to the previous one. Without a key anyone who can
+// write the file can recompute the chain, so it only detects accidental edits.
+func (r *AuditRecord) seal(prevHash string, key []byte) error {
+	r.PrevHash = prevHash
+	r.Hash = ""
+	raw, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	if len(key) == 0 {
+		sum := sha256.Sum256(raw)
+		r.Hash = hex.EncodeToString(sum[:])
+		return nil
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(raw)
+	r.Hash = hex.EncodeToString(mac.Sum(nil))
+	return nil
+}
+
+// AuditLog appends a JSON line for every mutating API call to a file. Every record includes the hash
+// of the previous one, so that removed or changed records break the chain. With a key the hashes are
+// HMACs, which can't be recomputed for changed records without knowing the key.
+type AuditLog struct {
+	path          string
+	includeBodies bool
+	key           []byte
+
+	mu       sync.Mutex
+	file     *os.File
+	lastHash string
+}
+
+var (
+	auditLogsMu sync.Mutex
+	auditLogs   = map[string]*AuditLog{}
+)
+
+// OpenAuditLog returns the audit log writing to the given file, which is shared by all provider
+// configurations of the process, so that the hash chain stays intact. Request bodies are redacted,
+// unless includeBodies is true. Records are sealed with an HMAC of the key, or with SHA-256 if it's empty.
+func OpenAuditLog(path string, includeBodies bool, key string) (*AuditLog, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("audit log: %w", err)
+	}
+	auditLogsMu.Lock()
+	defer auditLogsMu.Unlock()
+	if l, ok := auditLogs[abs]; ok {
+		if !hmac.Equal(l.key, []byte(key)) {
+			return nil, fmt.Errorf("audit log: %s is already written with a different key", abs)
+		}
+		l.mu.Lock()
+		l.includeBodies = l.includeBodies || includeBodies
+		l.mu.Unlock()
+		return l, nil
+	}
+	lastHash, err := lastAuditHash(abs)
+	if err != nil {
+		return nil, fmt.Errorf("audit log: %w", err)
+	}
+	file, err := os.OpenFile(abs, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("audit log: %w", err)
+	}
+	l := &AuditLog{
+		path:          abs,
+		includeBodies: includeBodies,
+		key:           []byte(key),
+		file:          file,
+		lastHash:      lastHash,
+	}
+	auditLogs[abs] = l
+	return l, nil
+}
+
+// lastAuditHash returns the hash of the last record in the existing file, to continue the chain
+func lastAuditHash(path string) (string, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	var last []byte
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			last = line
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	if last == nil {
+		return "", nil
+	}
+	var record AuditRecord
+	if err := json.Unmarshal(last, &record); err != nil {
+		return "", fmt.Errorf("last record of %s: %w", path, err)
+	}
+	return record.Hash, nil
+}
+
+// Path returns the absolute path of the audit log file
+func (l *AuditLog) Path() string {
+	return l.path
+}
+
+// Write seals the record and appends it to the file
+func (l *AuditLog) Write(record AuditRecord) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := record.seal(l.lastHash, l.key); err != nil {
+		return err
+	}
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if _, err := l.file.Write(append(raw, '\n')); err != nil {
+		return err
+	}
+	l.lastHash = record.Hash
+	return nil
+}
+
+// Configure wraps the HTTP transport of the config, so that mutating API calls are written to the log.
+// It has to be called before [RetryPolicy.Configure], so that every retry is recorded.
+func (l *AuditLog) Configure(cfg *config.Config) {
+	if l == nil {
+		return
+	}
+	cfg.HTTPTransport = &auditTransport{
+		log:  l,
+		next: nextTransport(cfg),
+	}
+}
+
+type auditScope struct {
+	resourceType string
+	resourceID   string
+	operation    string
+}
+
+type auditScopeContextKey struct{}
+
+// AuditInContext returns a context, that attributes API calls in the audit log to the given resource
+// type without the databricks_ prefix, resource ID and operation. Empty values are taken from the
+// parent context.
+func AuditInContext(ctx context.Context, resourceType, resourceID, operation string) context.Context {
+	scope := auditScopeFromContext(ctx)
+	if resourceType != "" {
+		scope.resourceType = resourceType
+	}
+	if resourceID != "" {
+		scope.resourceID = resourceID
+	}
+	if operation != "" {
+		scope.operation = operation
+	}
+	return context.WithValue(ctx, auditScopeContextKey{}, scope)
+}
+
+func auditScopeFromContext(ctx context.Context) auditScope {
+	scope, _ := ctx.Value(auditScopeContextKey{}).(auditScope)
+	return scope
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+type auditTransport struct {
+	log  *AuditLog
+	next http.RoundTripper
+}
+
+// SkipRetryOnIO keeps HTTP fixtures of unit tests working through the wrapper
+func (t *auditTransport) SkipRetryOnIO() bool {
+	skippable, ok := t.next.(interface {
+		SkipRetryOnIO() bool
+	})
+	return ok && skippable.SkipRetryOnIO()
+}
+
+func (t *auditTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isMutatingMethod(req.Method) {
+		return t.next.RoundTrip(req)
+	}
+	scope := auditScopeFromContext(req.Context())
+	record := AuditRecord{
+		Timestamp:    time.Now().UTC(),
+		ResourceType: scope.resourceType,
+		ResourceID:   scope.resourceID,
+		Operation:    scope.operation,
+		Method:       req.Method,
+		Path:         req.URL.Path,
+	}
+	t.log.mu.Lock()
+	includeBodies := t.log.includeBodies
+	t.log.mu.Unlock()
+	if includeBodies {
+		record.RequestBody = auditRequestBody(req)
+	}
+	resp, err := t.next.RoundTrip(req)
+	record.DurationMs = time.Since(record.Timestamp).Milliseconds()
+	if err != nil {
+		record.Error = err.Error()
+	} else {
+		record.Status = resp.StatusCode
+		record.RequestID = resp.Header.Get("X-Request-Id")
+	}
+	if werr := t.log.Write(record); werr != nil {
+		log.Printf("[ERROR] Cannot write %s %s to audit log %s: %s", req.Method, req.URL.Path, t.log.path, werr)
+	}
+	return resp, err
+}
+
+// auditRequestBody returns the request body with values of sensitive fields redacted
+func auditRequestBody(req *http.Request) any {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+	raw, err := io.ReadAll(body)
+	if err != nil || len(raw) == 0 {
+		return nil
+	}
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		// not JSON, e.g. a file upload
+		return redactedValue
+	}
+	return redactSensitiveFields(value)
+}
+
+func redactSensitiveFields(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			if isSensitiveField(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactSensitiveFields(nested)
+		}
+	case []any:
+		for i, nested := range v {
+			v[i] = redactSensitiveFields(nested)
+		}
+	}
+	return value
+}
+
+func isSensitiveField(key string) bool {
+	key = strings.ToLower(key)
+	for _, field := range sensitiveFields {
+		if strings.Contains(key, field) {
+			return true
+		}
+	}
+	return false
+}
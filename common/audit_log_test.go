@@ -0,0 +1,198 @@
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readAuditRecords(t *testing.T, path string) []AuditRecord {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	records := []AuditRecord{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record AuditRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+func assertAuditChain(t *testing.T, records []AuditRecord, key []byte) {
+	prevHash := ""
+	for i, record := range records {
+		hash := record.Hash
+		assert.Equal(t, prevHash, record.PrevHash, "record %d", i)
+		require.NoError(t, record.seal(record.PrevHash, key))
+		assert.Equal(t, hash, record.Hash, "record %d was changed", i)
+		prevHash = hash
+	}
+}
+
+func auditTestServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-"+r.Method)
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(404)
+		}
+		w.Write([]byte("{}"))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func auditTestClient(t *testing.T, includeBodies bool) (*http.Client, string) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	auditLog, err := OpenAuditLog(path, includeBodies, "")
+	require.NoError(t, err)
+	cfg := &config.Config{}
+	auditLog.Configure(cfg)
+	return &http.Client{Transport: cfg.HTTPTransport}, path
+}
+
+func TestAuditLogRecordsMutatingCalls(t *testing.T) {
+	server := auditTestServer(t)
+	client, path := auditTestClient(t, false)
+	ctx := AuditInContext(context.Background(), "job", "123", "update")
+
+	do := func(method, path, body string) {
+		req, err := http.NewRequestWithContext(ctx, method, server.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	do("GET", "/api/2.2/jobs/get", "")
+	do("POST", "/api/2.2/jobs/reset", `{"job_id":123}`)
+	do("DELETE", "/missing", "")
+
+	records := readAuditRecords(t, path)
+	require.Len(t, records, 2)
+	assert.Equal(t, "job", records[0].ResourceType)
+	assert.Equal(t, "123", records[0].ResourceID)
+	assert.Equal(t, "update", records[0].Operation)
+	assert.Equal(t, "POST", records[0].Method)
+	assert.Equal(t, "/api/2.2/jobs/reset", records[0].Path)
+	assert.Equal(t, 200, records[0].Status)
+	assert.Equal(t, "req-POST", records[0].RequestID)
+	assert.Nil(t, records[0].RequestBody)
+	assert.Equal(t, "DELETE", records[1].Method)
+	assert.Equal(t, 404, records[1].Status)
+	assertAuditChain(t, records, nil)
+}
+
+func TestAuditLogRequestBodiesAreRedacted(t *testing.T) {
+	server := auditTestServer(t)
+	client, path := auditTestClient(t, true)
+	body := `{"scope":"a","string_value":"s3cr3t","nested":[{"client_secret":"x","name":"y"}]}`
+	resp, err := client.Post(server.URL+"/api/2.0/secrets/put", "application/json", bytes.NewReader([]byte(body)))
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	records := readAuditRecords(t, path)
+	require.Len(t, records, 1)
+	assert.Equal(t, map[string]any{
+		"scope":        "a",
+		"string_value": redactedValue,
+		"nested": []any{
+			map[string]any{"client_secret": redactedValue, "name": "y"},
+		},
+	}, records[0].RequestBody)
+}
+
+func TestAuditLogRecordsErrors(t *testing.T) {
+	client, path := auditTestClient(t, false)
+	_, err := client.Post("http://127.0.0.1:0/api/2.0/clusters/create", "application/json", nil)
+	require.Error(t, err)
+
+	records := readAuditRecords(t, path)
+	require.Len(t, records, 1)
+	assert.Equal(t, 0, records[0].Status)
+	assert.NotEmpty(t, records[0].Error)
+}
+
+func TestAuditLogIsSharedAndContinuesChain(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	first, err := OpenAuditLog(path, false, "")
+	require.NoError(t, err)
+	second, err := OpenAuditLog(path, false, "")
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	_, err = OpenAuditLog(path, false, "other")
+	assert.ErrorContains(t, err, "already written with a different key")
+
+	require.NoError(t, first.Write(AuditRecord{Method: "POST", Path: "/a"}))
+	require.NoError(t, second.Write(AuditRecord{Method: "POST", Path: "/b"}))
+	records := readAuditRecords(t, path)
+	require.Len(t, records, 2)
+	assertAuditChain(t, records, nil)
+
+	// a new process continues from the last record in the file
+	lastHash, err := lastAuditHash(path)
+	require.NoError(t, err)
+	assert.Equal(t, records[1].Hash, lastHash)
+}
+
+func TestAuditLogDetectsTampering(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	auditLog, err := OpenAuditLog(path, false, "")
+	require.NoError(t, err)
+	require.NoError(t, auditLog.Write(AuditRecord{Method: "POST", Path: "/a", Status: 200}))
+
+	records := readAuditRecords(t, path)
+	record := records[0]
+	hash := record.Hash
+	record.Status = 500
+	require.NoError(t, record.seal(record.PrevHash, nil))
+	assert.NotEqual(t, hash, record.Hash)
+}
+
+func TestAuditLogWithKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	auditLog, err := OpenAuditLog(path, false, "s3cr3t")
+	require.NoError(t, err)
+	require.NoError(t, auditLog.Write(AuditRecord{Method: "POST", Path: "/a", Status: 200}))
+	require.NoError(t, auditLog.Write(AuditRecord{Method: "POST", Path: "/b", Status: 200}))
+
+	records := readAuditRecords(t, path)
+	require.Len(t, records, 2)
+	assertAuditChain(t, records, []byte("s3cr3t"))
+
+	// the chain can't be recomputed without the key
+	record := records[0]
+	record.Status = 500
+	require.NoError(t, record.seal(record.PrevHash, nil))
+	assert.NotEqual(t, records[1].PrevHash, record.Hash)
+	require.NoError(t, records[0].seal(records[0].PrevHash, nil))
+	assert.NotEqual(t, records[1].PrevHash, records[0].Hash)
+}
+
+func TestLastAuditHashOfMissingFile(t *testing.T) {
+	lastHash, err := lastAuditHash(filepath.Join(t.TempDir(), "missing.jsonl"))
+	require.NoError(t, err)
+	assert.Equal(t, "", lastHash)
+}
+
+func TestAuditInContextKeepsParentValues(t *testing.T) {
+	ctx := AuditInContext(context.Background(), "share", "", "")
+	ctx = AuditInContext(ctx, "", "abc", "create")
+	assert.Equal(t, auditScope{
+		resourceType: "share",
+		resourceID:   "abc",
+		operation:    "create",
+	}, auditScopeFromContext(ctx))
+}
@@ -0,0 +1,132 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+)
+
+// bulkReadKey identifies the result of a single list call, like all ACLs of a secret scope
+type bulkReadKey struct {
+	kind   string
+	parent string
+}
+
+// bulkReadEntry holds the result of a list call. done is closed once value and err are set,
+// so that concurrent reads of the same parent wait for a single in-flight call.
+type bulkReadEntry struct {
+	done  chan struct{}
+	value any
+	err   error
+}
+
+// bulkReadCache keeps the results of list calls, so that resources of the same kind are read
+// with one request per parent during plan and refresh, instead of one request per resource.
+type bulkReadCache struct {
+	mu      sync.Mutex
+	entries map[bulkReadKey]*bulkReadEntry
+}
+
+func newBulkReadCache() *bulkReadCache {
+	return &bulkReadCache{
+		entries: map[bulkReadKey]*bulkReadEntry{},
+	}
+}
+
+// entry returns the entry for the key and true if the caller has to fill it
+func (c *bulkReadCache) entry(key bulkReadKey) (*bulkReadEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		return e, false
+	}
+	e := &bulkReadEntry{done: make(chan struct{})}
+	c.entries[key] = e
+	return e, true
+}
+
+// forget removes the entry, if it's still the one that is cached for the key
+func (c *bulkReadCache) forget(key bulkReadKey, e *bulkReadEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries[key] == e {
+		delete(c.entries, key)
+	}
+}
+
+func (c *bulkReadCache) invalidate(key bulkReadKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+// EnableBulkReadCache makes [BulkRead] cache the results of list calls for the lifetime of the client
+func (c *DatabricksClient) EnableBulkReadCache() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.bulkReadCache == nil {
+		c.bulkReadCache = newBulkReadCache()
+	}
+}
+
+// BulkReadCacheEnabled returns true if the bulk read cache is enabled in the provider
+func (c *DatabricksClient) BulkReadCacheEnabled() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.bulkReadCache != nil
+}
+
+func (c *DatabricksClient) getBulkReadCache() *bulkReadCache {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.bulkReadCache
+}
+
+// InvalidateBulkRead drops the cached list of the given kind and parent. Resources call it after
+// every write, so that the following reads see the change.
+func (c *DatabricksClient) InvalidateBulkRead(kind, parent string) {
+	cache := c.getBulkReadCache()
+	if cache == nil {
+		return
+	}
+	cache.invalidate(bulkReadKey{kind, parent})
+}
+
+// BulkRead returns the result of the list call for the given kind and parent. When the bulk read
+// cache is enabled, list is called once and its result is shared by all reads of the same parent,
+// including concurrent ones. Errors are not cached. When the cache is disabled, list is called every time.
+func BulkRead[T any](ctx context.Context, c *DatabricksClient, kind, parent string,
+	list func(context.Context) (T, error)) (T, error) {
+	cache := c.getBulkReadCache()
+	if cache == nil {
+		return list(ctx)
+	}
+	key := bulkReadKey{kind, parent}
+	e, owner := cache.entry(key)
+	if owner {
+		log.Printf("[DEBUG] Listing all %s of %s for bulk read", kind, parent)
+		e.value, e.err = list(ctx)
+		if e.err != nil {
+			cache.forget(key, e)
+		}
+		close(e.done)
+	} else {
+		select {
+		case <-e.done:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+	if e.err != nil {
+		var zero T
+		return zero, e.err
+	}
+	value, ok := e.value.(T)
+	if !ok {
+		var zero T
+		return zero, fmt.Errorf("bulk read of %s has type %T, not %T", kind, e.value, zero)
+	}
+	return value, nil
+}
@@ -0,0 +1,117 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func countingList(calls *int32, values ...string) func(context.Context) ([]string, error) {
+	return func(context.Context) ([]string, error) {
+		atomic.AddInt32(calls, 1)
+		return values, nil
+	}
+}
+
+func TestBulkReadDisabled(t *testing.T) {
+	c := &DatabricksClient{}
+	var calls int32
+	for i := 0; i < 3; i++ {
+		values, err := BulkRead(context.Background(), c, "acls", "scope", countingList(&calls, "a"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a"}, values)
+	}
+	assert.False(t, c.BulkReadCacheEnabled())
+	assert.Equal(t, int32(3), calls)
+	// no-op without cache
+	c.InvalidateBulkRead("acls", "scope")
+}
+
+func TestBulkReadCachesPerParent(t *testing.T) {
+	c := &DatabricksClient{}
+	c.EnableBulkReadCache()
+	assert.True(t, c.BulkReadCacheEnabled())
+	ctx := context.Background()
+	var first, second int32
+	for i := 0; i < 3; i++ {
+		values, err := BulkRead(ctx, c, "acls", "first", countingList(&first, "a", "b"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, values)
+		values, err = BulkRead(ctx, c, "acls", "second", countingList(&second, "c"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"c"}, values)
+	}
+	assert.Equal(t, int32(1), first)
+	assert.Equal(t, int32(1), second)
+
+	c.InvalidateBulkRead("acls", "first")
+	_, err := BulkRead(ctx, c, "acls", "first", countingList(&first, "a"))
+	require.NoError(t, err)
+	_, err = BulkRead(ctx, c, "acls", "second", countingList(&second, "c"))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), first)
+	assert.Equal(t, int32(1), second)
+}
+
+func TestBulkReadConcurrentReadsShareOneCall(t *testing.T) {
+	c := &DatabricksClient{}
+	c.EnableBulkReadCache()
+	var calls int32
+	release := make(chan struct{})
+	list := func(context.Context) ([]string, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return []string{"a"}, nil
+	}
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := BulkRead(context.Background(), c, "members", "group", list)
+			errs <- err
+		}()
+	}
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), calls)
+}
+
+func TestBulkReadDoesNotCacheErrors(t *testing.T) {
+	c := &DatabricksClient{}
+	c.EnableBulkReadCache()
+	ctx := context.Background()
+	_, err := BulkRead(ctx, c, "acls", "scope", func(context.Context) ([]string, error) {
+		return nil, fmt.Errorf("nope")
+	})
+	assert.EqualError(t, err, "nope")
+	var calls int32
+	values, err := BulkRead(ctx, c, "acls", "scope", countingList(&calls, "a"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, values)
+	assert.Equal(t, int32(1), calls)
+}
+
+func TestBulkReadTypeMismatch(t *testing.T) {
+	c := &DatabricksClient{}
+	c.EnableBulkReadCache()
+	ctx := context.Background()
+	_, err := BulkRead(ctx, c, "acls", "scope", func(context.Context) (int, error) {
+		return 1, nil
+	})
+	require.NoError(t, err)
+	_, err = BulkRead(ctx, c, "acls", "scope", func(context.Context) (string, error) {
+		return "a", nil
+	})
+	assert.EqualError(t, err, "bulk read of acls has type int, not string")
+}
@@ -68,6 +68,19 @@ type DatabricksClient struct {
 	// configured for the provider
 	cachedAccountClient *databricks.AccountClient
 
+	// selfIp is the public IP address of the machine running Terraform, as
+	// configured in the provider. Empty means it has to be probed.
+	selfIp string
+
+	// retryPolicy configures client-side retries and rate limiting, as configured in the provider
+	retryPolicy RetryPolicy
+
+	// bulkReadCache keeps results of list calls shared by reads of many resources, if enabled in the provider
+	bulkReadCache *bulkReadCache
+
+	// commandContexts keeps execution contexts of API 1.2 for reuse between commands
+	commandContexts *CommandContextPool
+
 	// mu synchronizes access to all cached clients.
 	mu sync.Mutex
 }
@@ -147,6 +160,27 @@ func (c *DatabricksClient) SetAccountClient(a *databricks.AccountClient) {
 	c.cachedAccountClient = a
 }
 
+// SetSelfIP sets the public IP address of the machine running Terraform.
+func (c *DatabricksClient) SetSelfIP(ip string) {
+	c.selfIp = ip
+}
+
+// SelfIP returns the public IP address of the machine running Terraform, if configured.
+func (c *DatabricksClient) SelfIP() string {
+	return c.selfIp
+}
+
+// SetRetryPolicy sets the retry policy configured in the provider. It doesn't change the HTTP transport,
+// which has to be configured with [RetryPolicy.Configure] before the client is created.
+func (c *DatabricksClient) SetRetryPolicy(p RetryPolicy) {
+	c.retryPolicy = p
+}
+
+// RetryPolicy returns the retry policy configured in the provider
+func (c *DatabricksClient) RetryPolicy() RetryPolicy {
+	return c.retryPolicy
+}
+
 func (c *DatabricksClient) setAccountId(accountId string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -353,10 +387,16 @@ func (c *DatabricksClient) ClientForHost(ctx context.Context, url string) (*Data
 		return nil, fmt.Errorf("cannot configure new client: %w", err)
 	}
 	// copy all client configuration options except Databricks CLI profile
-	return &DatabricksClient{
+	hostClient := &DatabricksClient{
 		DatabricksClient: client,
 		commandFactory:   c.commandFactory,
-	}, nil
+		selfIp:           c.selfIp,
+		retryPolicy:      c.retryPolicy,
+	}
+	if c.BulkReadCacheEnabled() {
+		hostClient.EnableBulkReadCache()
+	}
+	return hostClient, nil
 }
 
 func (aa *DatabricksClient) GetAzureJwtProperty(key string) (any, error) {
@@ -0,0 +1,252 @@
+package common
+
+import (
+	"context"
+	"log"
+	"slices"
+	"sync"
+	"time"
+)
+
+// maxCommandContextsPerCluster limits execution contexts, idle or busy, that the provider keeps
+// on a single cluster. Clusters have a limit of contexts shared by all users.
+const maxCommandContextsPerCluster = 8
+
+// commandContextIdleTimeout is how long an idle execution context is kept for the next command
+var commandContextIdleTimeout = 2 * time.Minute
+
+// CommandContext is an execution context of API 1.2 on a cluster, that could be reused
+// by subsequent commands of the same resource type in the same language.
+type CommandContext struct {
+	ID        string
+	ClusterID string
+	Language  string
+	// Owner is the resource type, that the context is reused by. Contexts keep session state,
+	// like the current catalog or Python variables, so they aren't shared between resource types.
+	Owner string
+
+	// destroy removes the context from the cluster
+	destroy func(ctx context.Context) error
+	// expiry destroys the context, when it stays idle for commandContextIdleTimeout
+	expiry *time.Timer
+}
+
+type commandContextKey struct {
+	owner     string
+	clusterID string
+	language  string
+}
+
+type commandContextReuseKey struct{}
+
+// WithoutCommandContextReuse makes commands run with the returned context in a new execution
+// context, which is destroyed afterwards. It's used for arbitrary code of users, which state
+// must not leak into commands of the provider.
+func WithoutCommandContextReuse(ctx context.Context) context.Context {
+	return context.WithValue(ctx, commandContextReuseKey{}, false)
+}
+
+// CommandContextOwner returns the owner of execution contexts for commands run with the given
+// context, or an empty string, if execution contexts must not be reused.
+func CommandContextOwner(ctx context.Context) string {
+	if reuse, ok := ctx.Value(commandContextReuseKey{}).(bool); ok && !reuse {
+		return ""
+	}
+	return ResourceName.GetOrUnknown(ctx)
+}
+
+// CommandContextPool keeps idle execution contexts per resource type, cluster and language.
+// A context is given to only one command at a time, so commands are serialized per context.
+type CommandContextPool struct {
+	mu   sync.Mutex
+	idle map[commandContextKey][]*CommandContext
+	// open counts contexts per cluster, that are reserved, idle or busy
+	open map[string]int
+	// changed is closed and replaced when a context is destroyed, to wake up reservations
+	changed chan struct{}
+}
+
+var (
+	commandContextPools   []*CommandContextPool
+	commandContextPoolsMu sync.Mutex
+)
+
+// CommandContexts returns the pool of execution contexts for this client
+func (c *DatabricksClient) CommandContexts() *CommandContextPool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.commandContexts == nil {
+		c.commandContexts = &CommandContextPool{
+			idle:    map[commandContextKey][]*CommandContext{},
+			open:    map[string]int{},
+			changed: make(chan struct{}),
+		}
+		commandContextPoolsMu.Lock()
+		commandContextPools = append(commandContextPools, c.commandContexts)
+		commandContextPoolsMu.Unlock()
+	}
+	return c.commandContexts
+}
+
+// NewCommandContext creates a pooled context with a function to destroy it on the cluster
+func NewCommandContext(id, owner, clusterID, language string, destroy func(ctx context.Context) error) *CommandContext {
+	return &CommandContext{
+		ID:        id,
+		Owner:     owner,
+		ClusterID: clusterID,
+		Language:  language,
+		destroy:   destroy,
+	}
+}
+
+// Destroy removes the context from the cluster
+func (cc *CommandContext) Destroy(ctx context.Context) error {
+	if cc.destroy == nil {
+		return nil
+	}
+	return cc.destroy(ctx)
+}
+
+// Acquire takes an idle context of the owner for the given cluster and language out of the pool
+// or returns nil, if there's none.
+func (p *CommandContextPool) Acquire(owner, clusterID, language string) *CommandContext {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	key := commandContextKey{owner, clusterID, language}
+	idle := p.idle[key]
+	if len(idle) == 0 {
+		return nil
+	}
+	cc := idle[len(idle)-1]
+	p.idle[key] = idle[:len(idle)-1]
+	// if the timer has already fired, expire won't find the context among idle ones
+	cc.expiry.Stop()
+	return cc
+}
+
+// Reserve makes room for a new context on the cluster. When the cluster already has
+// maxCommandContextsPerCluster contexts, an idle one of any language is destroyed, or, if all
+// of them are busy, Reserve waits for one to be destroyed. The reservation is given back with
+// [CommandContextPool.Discard] or [CommandContextPool.Unreserve].
+func (p *CommandContextPool) Reserve(ctx context.Context, clusterID string) error {
+	for {
+		p.mu.Lock()
+		if p.open[clusterID] < maxCommandContextsPerCluster {
+			p.open[clusterID]++
+			p.mu.Unlock()
+			return nil
+		}
+		var evicted *CommandContext
+		for key, idle := range p.idle {
+			if key.clusterID == clusterID && len(idle) > 0 {
+				evicted = idle[0]
+				p.idle[key] = idle[1:]
+				evicted.expiry.Stop()
+				break
+			}
+		}
+		changed := p.changed
+		p.mu.Unlock()
+		if evicted != nil {
+			p.Discard(ctx, evicted)
+			continue
+		}
+		log.Printf("[DEBUG] Waiting for one of %d execution contexts on %s", maxCommandContextsPerCluster, clusterID)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// Unreserve gives back the reservation of a context, that wasn't created
+func (p *CommandContextPool) Unreserve(clusterID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.open[clusterID] > 0 {
+		p.open[clusterID]--
+	}
+	close(p.changed)
+	p.changed = make(chan struct{})
+}
+
+// Discard destroys the context on the cluster on the best effort basis and gives back
+// its reservation
+func (p *CommandContextPool) Discard(ctx context.Context, cc *CommandContext) {
+	err := cc.Destroy(ctx)
+	if err != nil {
+		log.Printf("[WARN] Cannot destroy execution context %s on %s: %s", cc.ID, cc.ClusterID, err)
+	}
+	p.Unreserve(cc.ClusterID)
+}
+
+// Release returns the context to the pool, so that the next command of the same owner could
+// reuse it within commandContextIdleTimeout
+func (p *CommandContextPool) Release(cc *CommandContext) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	key := commandContextKey{cc.Owner, cc.ClusterID, cc.Language}
+	p.idle[key] = append(p.idle[key], cc)
+	cc.expiry = time.AfterFunc(commandContextIdleTimeout, func() {
+		p.expire(cc)
+	})
+}
+
+// expire destroys the context, if it's still idle
+func (p *CommandContextPool) expire(cc *CommandContext) {
+	p.mu.Lock()
+	key := commandContextKey{cc.Owner, cc.ClusterID, cc.Language}
+	idle := p.idle[key]
+	i := slices.Index(idle, cc)
+	if i >= 0 {
+		p.idle[key] = slices.Delete(idle, i, i+1)
+	}
+	p.mu.Unlock()
+	if i >= 0 {
+		log.Printf("[DEBUG] Destroying execution context %s on %s, that is idle for %s",
+			cc.ID, cc.ClusterID, commandContextIdleTimeout)
+		p.Discard(context.Background(), cc)
+	}
+}
+
+// Len returns the number of idle contexts in the pool
+func (p *CommandContextPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	count := 0
+	for _, idle := range p.idle {
+		count += len(idle)
+	}
+	return count
+}
+
+// Close destroys all idle contexts and empties the pool
+func (p *CommandContextPool) Close(ctx context.Context) {
+	p.mu.Lock()
+	idle := p.idle
+	p.idle = map[commandContextKey][]*CommandContext{}
+	p.mu.Unlock()
+	var wg sync.WaitGroup
+	for _, contexts := range idle {
+		for _, cc := range contexts {
+			cc.expiry.Stop()
+			wg.Add(1)
+			go func(cc *CommandContext) {
+				defer wg.Done()
+				p.Discard(ctx, cc)
+			}(cc)
+		}
+	}
+	wg.Wait()
+}
+
+// CloseCommandContexts destroys idle execution contexts of all clients, when the provider stops
+func CloseCommandContexts(ctx context.Context) {
+	commandContextPoolsMu.Lock()
+	pools := slices.Clone(commandContextPools)
+	commandContextPoolsMu.Unlock()
+	for _, pool := range pools {
+		pool.Close(ctx)
+	}
+}
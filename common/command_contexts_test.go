@@ -0,0 +1,111 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandContextPool(t *testing.T) {
+	c := &DatabricksClient{}
+	pool := c.CommandContexts()
+	assert.Same(t, pool, c.CommandContexts())
+	assert.Nil(t, pool.Acquire("mount", "abc", "python"))
+
+	destroyed := []string{}
+	destroy := func(id string) func(context.Context) error {
+		return func(context.Context) error {
+			destroyed = append(destroyed, id)
+			if id == "broken" {
+				return fmt.Errorf("nope")
+			}
+			return nil
+		}
+	}
+	pool.Release(NewCommandContext("py", "mount", "abc", "python", destroy("py")))
+	assert.Nil(t, pool.Acquire("mount", "abc", "scala"))
+	// session state isn't shared between resource types
+	assert.Nil(t, pool.Acquire("sql_table", "abc", "python"))
+	assert.Nil(t, pool.Acquire("mount", "def", "python"))
+	cc := pool.Acquire("mount", "abc", "python")
+	require.NotNil(t, cc)
+	assert.Equal(t, "py", cc.ID)
+	assert.Nil(t, pool.Acquire("mount", "abc", "python"))
+
+	pool.Release(cc)
+	assert.Equal(t, 1, pool.Len())
+	CloseCommandContexts(context.Background())
+	assert.Equal(t, 0, pool.Len())
+	assert.Equal(t, []string{"py"}, destroyed)
+
+	pool.Release(NewCommandContext("broken", "mount", "abc", "python", destroy("broken")))
+	pool.Close(context.Background())
+	assert.Equal(t, 0, pool.Len())
+	assert.Equal(t, []string{"py", "broken"}, destroyed)
+}
+
+func TestCommandContextPoolReserve(t *testing.T) {
+	pool := (&DatabricksClient{}).CommandContexts()
+	ctx := context.Background()
+	destroyed := 0
+	for i := 0; i < maxCommandContextsPerCluster; i++ {
+		require.NoError(t, pool.Reserve(ctx, "abc"))
+		pool.Release(NewCommandContext(fmt.Sprint(i), "mount", "abc", "python", func(context.Context) error {
+			destroyed++
+			return nil
+		}))
+	}
+	// other clusters have their own limit
+	require.NoError(t, pool.Reserve(ctx, "def"))
+	pool.Unreserve("def")
+
+	// an idle context is destroyed to make room for the new one
+	require.NoError(t, pool.Reserve(ctx, "abc"))
+	assert.Equal(t, 1, destroyed)
+	assert.Equal(t, maxCommandContextsPerCluster-1, pool.Len())
+
+	// all contexts are busy
+	for i := 0; i < maxCommandContextsPerCluster-1; i++ {
+		require.NotNil(t, pool.Acquire("mount", "abc", "python"))
+	}
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, pool.Reserve(timeout, "abc"), context.DeadlineExceeded)
+
+	go pool.Unreserve("abc")
+	assert.NoError(t, pool.Reserve(ctx, "abc"))
+}
+
+func TestCommandContextExpiresWhenIdle(t *testing.T) {
+	previous := commandContextIdleTimeout
+	commandContextIdleTimeout = 10 * time.Millisecond
+	defer func() {
+		commandContextIdleTimeout = previous
+	}()
+	pool := (&DatabricksClient{}).CommandContexts()
+	destroyed := make(chan string, 2)
+	destroy := func(ctx context.Context) error {
+		destroyed <- "py"
+		return nil
+	}
+	pool.Release(NewCommandContext("py", "mount", "abc", "python", destroy))
+	assert.Equal(t, "py", <-destroyed)
+	assert.Equal(t, 0, pool.Len())
+	require.NoError(t, pool.Reserve(context.Background(), "abc"), "reservation is given back")
+
+	// acquired contexts don't expire
+	pool.Release(NewCommandContext("py", "mount", "abc", "python", destroy))
+	require.NotNil(t, pool.Acquire("mount", "abc", "python"))
+	time.Sleep(5 * commandContextIdleTimeout)
+	assert.Empty(t, destroyed)
+}
+
+func TestCommandContextOwner(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ResourceName, "mount")
+	assert.Equal(t, "mount", CommandContextOwner(ctx))
+	assert.Equal(t, "", CommandContextOwner(WithoutCommandContextReuse(ctx)))
+}
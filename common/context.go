@@ -16,7 +16,7 @@ const sdkName = "sdkv2"
 func AddContextToAllResources(p *schema.Provider, prefix string) {
 	for k, r := range p.DataSourcesMap {
 		name := strings.ReplaceAll(k, prefix+"_", "")
-		wrap := op(r.ReadContext).addContext(ResourceName, name).addContext(IsData, "yes").addContext(Sdk, sdkName)
+		wrap := op(r.ReadContext).withRetryPolicy().withAudit(name, "read").addContext(ResourceName, name).addContext(IsData, "yes").addContext(Sdk, sdkName)
 		r.ReadContext = schema.ReadContextFunc(wrap)
 	}
 	for k, r := range p.ResourcesMap {
@@ -44,20 +44,48 @@ func (f op) addContext(k contextKey, v string) op {
 	}
 }
 
+// withRetryPolicy makes the retry policy of the provider available to [RetryOnTimeout] and [RetryOn504]
+func (f op) withRetryPolicy() op {
+	return func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+		if c, ok := m.(*DatabricksClient); ok {
+			ctx = c.RetryPolicy().InContext(ctx)
+		}
+		return f(ctx, d, m)
+	}
+}
+
+// withAudit attributes mutating API calls in the audit log to the resource and operation
+func (f op) withAudit(name, operation string) op {
+	return func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+		id := ""
+		if d != nil {
+			id = d.Id()
+		}
+		ctx = AuditInContext(ctx, name, id, operation)
+		return f(ctx, d, m)
+	}
+}
+
+// AddContextToResource adds the same context to operations of the resource, as the provider adds to registered
+// resources. It's used for resources, that are called outside of the SDKv2 provider, e.g. to list them.
+func AddContextToResource(name string, r *schema.Resource) {
+	addContextToResource(name, r)
+}
+
 func addContextToResource(name string, r *schema.Resource) {
-	addName := func(a op) func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-		return a.addContext(ResourceName, name).addContext(Sdk, sdkName)
+	addName := func(a op, operation string) func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+		return a.withRetryPolicy().withAudit(name, operation).addContext(ResourceName, name).addContext(Sdk, sdkName)
 	}
 	if r.CreateContext != nil {
-		r.CreateContext = addName(op(r.CreateContext))
+		r.CreateContext = addName(op(r.CreateContext), "create")
 	}
 	if r.ReadContext != nil {
-		r.ReadContext = addName(op(r.ReadContext))
+		r.ReadContext = addName(op(r.ReadContext), "read")
 	}
 	if r.UpdateContext != nil {
-		r.UpdateContext = addName(op(r.UpdateContext))
+		r.UpdateContext = addName(op(r.UpdateContext), "update")
 	}
 	if r.DeleteContext != nil {
-		r.DeleteContext = addName(op(r.DeleteContext))
+		r.DeleteContext = addName(op(r.DeleteContext), "delete")
 	}
 }
@@ -33,3 +33,36 @@ func TestAddContextToAllResources(t *testing.T) {
 	AddContextToAllResources(p, "foo")
 	p.ResourcesMap["foo_bar"].CreateContext(context.Background(), nil, nil)
 }
+
+func TestAddContextToAllResourcesAudit(t *testing.T) {
+	checkOperation := func(operation string) func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, i any) diag.Diagnostics {
+			assert.Equal(t, auditScope{
+				resourceType: "bar",
+				resourceID:   "abc",
+				operation:    operation,
+			}, auditScopeFromContext(ctx))
+			return nil
+		}
+	}
+	r := &schema.Resource{
+		Schema:        map[string]*schema.Schema{},
+		CreateContext: checkOperation("create"),
+		ReadContext:   checkOperation("read"),
+		UpdateContext: checkOperation("update"),
+		DeleteContext: checkOperation("delete"),
+	}
+	p := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"foo_bar": r,
+		},
+	}
+	AddContextToAllResources(p, "foo")
+	d := r.TestResourceData()
+	d.SetId("abc")
+	ctx := context.Background()
+	r.CreateContext(ctx, d, nil)
+	r.ReadContext(ctx, d, nil)
+	r.UpdateContext(ctx, d, nil)
+	r.DeleteContext(ctx, d, nil)
+}
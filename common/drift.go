@@ -0,0 +1,190 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// DriftWarn reports changes made outside of Terraform as a warning
+	DriftWarn = "warn"
+	// DriftFail fails reading of a resource that was changed outside of Terraform
+	DriftFail = "fail"
+
+	// maximum number of changed attributes listed in the drift report
+	maxDriftPaths = 20
+)
+
+var driftFields = []string{"on_drift", "settings_hash", "last_modified_by"}
+
+// Warning is returned from Create, Read or Update to report a problem as a warning diagnostic,
+// after the state is updated
+type Warning struct {
+	Summary string
+	Detail  string
+}
+
+func (w *Warning) Error() string {
+	if w.Detail == "" {
+		return w.Summary
+	}
+	return w.Summary + ": " + w.Detail
+}
+
+// AddDriftFields adds `on_drift` and `settings_hash` attributes to report changes made outside
+// of Terraform
+func (s *CustomizableSchema) AddDriftFields() *CustomizableSchema {
+	return s.AddNewField("on_drift", &schema.Schema{
+		Optional:     true,
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{DriftWarn, DriftFail}, false),
+	}).AddNewField("settings_hash", &schema.Schema{
+		Computed: true,
+		Type:     schema.TypeString,
+	})
+}
+
+// AddLastModifiedByField adds `last_modified_by` attribute for resources, which API reports who
+// edited them
+func (s *CustomizableSchema) AddLastModifiedByField() *CustomizableSchema {
+	return s.AddNewField("last_modified_by", &schema.Schema{
+		Computed: true,
+		Type:     schema.TypeString,
+	})
+}
+
+// ResetDrift accepts the settings read after create or update as the ones applied by Terraform
+func ResetDrift(d *schema.ResourceData) {
+	d.Set("settings_hash", "")
+	// resources without `last_modified_by` ignore the error
+	d.Set("last_modified_by", "")
+}
+
+// Drift detects changes of a resource made outside of Terraform by comparing the state before
+// and after reading the resource
+type Drift struct {
+	ignored []string
+	prior   map[string]string
+}
+
+// NewDrift remembers the state before reading the resource. Ignored top-level attributes aren't
+// compared, like the status of the resource that changes without anyone editing it.
+func NewDrift(d *schema.ResourceData, ignored ...string) *Drift {
+	dr := &Drift{ignored: ignored}
+	dr.prior = dr.settings(d)
+	return dr
+}
+
+func (dr *Drift) settings(d *schema.ResourceData) map[string]string {
+	settings := map[string]string{}
+	state := d.State()
+	if state == nil {
+		return settings
+	}
+	for k, v := range state.Attributes {
+		top := strings.SplitN(k, ".", 2)[0]
+		if top == "id" || slices.Contains(driftFields, top) || slices.Contains(dr.ignored, top) {
+			continue
+		}
+		settings[k] = v
+	}
+	return settings
+}
+
+func settingsHash(settings map[string]string) string {
+	keys := make([]string, 0, len(settings))
+	for k := range settings {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var sb strings.Builder
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "%s=%s\n", k, settings[k])
+	}
+	return CalculateMd5Hash([]byte(sb.String()))
+}
+
+// changedPaths returns sorted attribute paths that differ between two flattened states
+func changedPaths(before, after map[string]string) []string {
+	seen := map[string]bool{}
+	add := func(k string) {
+		// counts of lists and maps change together with their items
+		k = strings.TrimSuffix(strings.TrimSuffix(k, ".#"), ".%")
+		seen[k] = true
+	}
+	for k, v := range before {
+		if after[k] != v {
+			add(k)
+		}
+	}
+	for k := range after {
+		if _, ok := before[k]; !ok {
+			add(k)
+		}
+	}
+	paths := make([]string, 0, len(seen))
+	for k := range seen {
+		paths = append(paths, k)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Check compares the settings read from the workspace with the ones last applied by Terraform.
+// If they differ, it records who changed them and reports the changed attributes according to
+// `on_drift`. The modifiedBy callback is only called when the settings differ, as it may call the
+// API. It's nil for resources without `last_modified_by`, because their API doesn't report who
+// edited them.
+func (dr *Drift) Check(ctx context.Context, d *schema.ResourceData, modifiedBy func() string) error {
+	current := dr.settings(d)
+	hash := settingsHash(current)
+	applied := d.Get("settings_hash").(string)
+	if err := d.Set("settings_hash", hash); err != nil {
+		return err
+	}
+	if applied == "" || applied == hash {
+		return nil
+	}
+	editor := ""
+	if modifiedBy != nil {
+		editor = modifiedBy()
+		if editor == "" {
+			editor = "unknown"
+		}
+		if err := d.Set("last_modified_by", editor); err != nil {
+			return err
+		}
+	}
+	paths := changedPaths(dr.prior, current)
+	if len(paths) > maxDriftPaths {
+		paths = append(paths[:maxDriftPaths], fmt.Sprintf("and %d more", len(paths)-maxDriftPaths))
+	}
+	changes := "attributes that aren't in the state"
+	if len(paths) > 0 {
+		changes = strings.Join(paths, ", ")
+	}
+	name := ResourceName.GetOrUnknown(ctx)
+	if name == "unknown" {
+		name = "resource"
+	}
+	summary := fmt.Sprintf("%s %s was changed outside of Terraform", strings.ReplaceAll(name, "_", " "), d.Id())
+	detail := fmt.Sprintf("changed: %s", changes)
+	if editor != "" {
+		detail += fmt.Sprintf("; modified by: %s", editor)
+	}
+	switch d.Get("on_drift").(string) {
+	case DriftWarn:
+		return &Warning{Summary: summary, Detail: detail}
+	case DriftFail:
+		return fmt.Errorf("%s: %s", summary, detail)
+	}
+	log.Printf("[INFO] %s: %s", summary, detail)
+	return nil
+}
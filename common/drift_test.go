@@ -0,0 +1,159 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type driftTestResource struct {
+	Name   string            `json:"name"`
+	Tags   map[string]string `json:"tags,omitempty"`
+	Status string            `json:"status,omitempty" tf:"computed"`
+}
+
+func (driftTestResource) CustomizeSchema(s *CustomizableSchema) *CustomizableSchema {
+	return s.AddDriftFields().AddLastModifiedByField()
+}
+
+var driftTestSchema = StructToSchema(driftTestResource{}, nil)
+
+func someoneModified() string {
+	return "someone@example.com"
+}
+
+func driftTestRead(remote driftTestResource, modifiedBy func() string) Resource {
+	return Resource{
+		Schema: driftTestSchema,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			drift := NewDrift(d, "status")
+			if err := StructToData(remote, driftTestSchema, d); err != nil {
+				return err
+			}
+			return drift.Check(ctx, d, modifiedBy)
+		},
+	}
+}
+
+func readDriftTestResource(t *testing.T, remote driftTestResource, state map[string]string) (*schema.ResourceData, diag.Diagnostics) {
+	r := driftTestRead(remote, someoneModified).ToResource()
+	d := r.Data(&terraform.InstanceState{ID: "abc", Attributes: state})
+	diags := r.ReadContext(context.Background(), d, &DatabricksClient{})
+	return d, diags
+}
+
+func TestDriftBaseline(t *testing.T) {
+	d, diags := readDriftTestResource(t, driftTestResource{Name: "a", Status: "RUNNING"}, map[string]string{
+		"name":     "a",
+		"on_drift": DriftFail,
+	})
+	assert.False(t, diags.HasError())
+	assert.NotEqual(t, "", d.Get("settings_hash"))
+	assert.Equal(t, "", d.Get("last_modified_by"))
+}
+
+func TestDriftNoChanges(t *testing.T) {
+	d, _ := readDriftTestResource(t, driftTestResource{Name: "a"}, map[string]string{"name": "a"})
+	hash := d.Get("settings_hash").(string)
+
+	d, diags := readDriftTestResource(t, driftTestResource{Name: "a", Status: "STOPPED"}, map[string]string{
+		"name":          "a",
+		"status":        "RUNNING",
+		"on_drift":      DriftFail,
+		"settings_hash": hash,
+	})
+	assert.Len(t, diags, 0)
+	assert.Equal(t, hash, d.Get("settings_hash"))
+	assert.Equal(t, "", d.Get("last_modified_by"))
+}
+
+func TestDriftWarn(t *testing.T) {
+	d, _ := readDriftTestResource(t, driftTestResource{Name: "a", Tags: map[string]string{"team": "data"}}, map[string]string{
+		"name":      "a",
+		"tags.%":    "1",
+		"tags.team": "data",
+	})
+	hash := d.Get("settings_hash").(string)
+
+	d, diags := readDriftTestResource(t, driftTestResource{Name: "b", Tags: map[string]string{"team": "ml"}}, map[string]string{
+		"name":          "a",
+		"tags.%":        "1",
+		"tags.team":     "data",
+		"on_drift":      DriftWarn,
+		"settings_hash": hash,
+	})
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "resource abc was changed outside of Terraform", diags[0].Summary)
+	assert.Equal(t, "changed: name, tags.team; modified by: someone@example.com", diags[0].Detail)
+	assert.Equal(t, "someone@example.com", d.Get("last_modified_by"))
+	assert.Equal(t, "b", d.Get("name"))
+	assert.NotEqual(t, hash, d.Get("settings_hash"))
+}
+
+func TestDriftFail(t *testing.T) {
+	_, diags := readDriftTestResource(t, driftTestResource{Name: "b"}, map[string]string{
+		"name":          "a",
+		"on_drift":      DriftFail,
+		"settings_hash": "outdated",
+	})
+	require.True(t, diags.HasError())
+	assert.Equal(t, "resource abc was changed outside of Terraform: changed: name; modified by: someone@example.com",
+		diags[0].Summary)
+}
+
+func TestDriftUnknownEditor(t *testing.T) {
+	r := driftTestRead(driftTestResource{Name: "b"}, func() string { return "" }).ToResource()
+	d := r.Data(&terraform.InstanceState{ID: "abc", Attributes: map[string]string{
+		"name":          "a",
+		"on_drift":      DriftWarn,
+		"settings_hash": "outdated",
+	}})
+	diags := r.ReadContext(context.Background(), d, &DatabricksClient{})
+	require.Len(t, diags, 1)
+	assert.Equal(t, "changed: name; modified by: unknown", diags[0].Detail)
+	assert.Equal(t, "unknown", d.Get("last_modified_by"))
+}
+
+func TestDriftWithoutEditor(t *testing.T) {
+	r := driftTestRead(driftTestResource{Name: "b"}, nil).ToResource()
+	d := r.Data(&terraform.InstanceState{ID: "abc", Attributes: map[string]string{
+		"name":          "a",
+		"on_drift":      DriftWarn,
+		"settings_hash": "outdated",
+	}})
+	diags := r.ReadContext(context.Background(), d, &DatabricksClient{})
+	require.Len(t, diags, 1)
+	assert.Equal(t, "changed: name", diags[0].Detail)
+	assert.Equal(t, "", d.Get("last_modified_by"))
+}
+
+func TestDriftResetAfterUpdate(t *testing.T) {
+	r := driftTestRead(driftTestResource{Name: "b"}, someoneModified).ToResource()
+	d := r.Data(&terraform.InstanceState{ID: "abc", Attributes: map[string]string{
+		"name":          "a",
+		"on_drift":      DriftFail,
+		"settings_hash": "outdated",
+	}})
+	ResetDrift(d)
+	diags := r.ReadContext(context.Background(), d, &DatabricksClient{})
+	assert.Len(t, diags, 0)
+	assert.NotEqual(t, "outdated", d.Get("settings_hash"))
+}
+
+func TestChangedPaths(t *testing.T) {
+	assert.Equal(t, []string{"task", "task.1.task_key", "timeout_seconds"}, changedPaths(map[string]string{
+		"task.#":          "1",
+		"task.0.task_key": "a",
+		"timeout_seconds": "10",
+	}, map[string]string{
+		"task.#":          "2",
+		"task.0.task_key": "a",
+		"task.1.task_key": "b",
+	}))
+}
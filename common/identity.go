@@ -0,0 +1,116 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceIdentity maps the ID of the resource to named attributes, that Terraform 1.12+ uses to import resources
+// with `import { identity = { ... } }` blocks, so that users don't need to know the format of the ID of every resource
+type ResourceIdentity struct {
+	// Attributes, that are joined with Separator to form the ID of the resource
+	Attributes []string
+	// Separator of attributes in the ID, required when there is more than one attribute
+	Separator string
+
+	// mutable is set for resources, which ID changes on update, e.g. on rename
+	mutable bool
+}
+
+// NewIdentity creates the identity of a resource, which ID is the value of the single attribute
+func NewIdentity(attribute string) *ResourceIdentity {
+	return &ResourceIdentity{Attributes: []string{attribute}}
+}
+
+// NewSeparatedIdentity creates the identity of a resource, which ID is made of attributes joined with the separator
+func NewSeparatedIdentity(separator string, attributes ...string) *ResourceIdentity {
+	return &ResourceIdentity{Attributes: attributes, Separator: separator}
+}
+
+// Mutable allows the identity to change on update, like for resources, that can be renamed.
+// Otherwise Terraform rejects the changed identity after the update.
+func (ri *ResourceIdentity) Mutable() *ResourceIdentity {
+	ri.mutable = true
+	return ri
+}
+
+// Identity returns the identity of the resource, that uses the ID pair
+func (p *Pair) Identity() *ResourceIdentity {
+	return NewSeparatedIdentity(p.separator, p.left, p.right)
+}
+
+func (ri *ResourceIdentity) toSchema() *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := map[string]*schema.Schema{}
+			for _, attr := range ri.Attributes {
+				s[attr] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+				}
+			}
+			return s
+		},
+	}
+}
+
+// Split returns the values of identity attributes, in the same order as Attributes, from the ID of the resource.
+// It returns false, if the ID has unexpected format.
+func (ri *ResourceIdentity) Split(id string) ([]string, bool) {
+	parts := []string{id}
+	if len(ri.Attributes) > 1 {
+		parts = strings.SplitN(id, ri.Separator, len(ri.Attributes))
+	}
+	return parts, len(parts) == len(ri.Attributes)
+}
+
+// setIdentity sets the identity attributes from the ID of the resource. IDs in unexpected format, e.g. from
+// old provider versions, are only logged.
+func (ri *ResourceIdentity) setIdentity(d *schema.ResourceData) error {
+	if d.Id() == "" {
+		return nil
+	}
+	parts, ok := ri.Split(d.Id())
+	if !ok {
+		log.Printf("[WARN] Can't set identity of %s: unexpected format of ID", d.Id())
+		return nil
+	}
+	identity, err := d.Identity()
+	if err != nil {
+		// resource data, that is created outside of the provider server, e.g. in unit tests, has no identity schema
+		log.Printf("[DEBUG] Can't set identity of %s: %s", d.Id(), err)
+		return nil
+	}
+	for i, attr := range ri.Attributes {
+		if err = identity.Set(attr, parts[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importer wraps the importer of the resource to set the ID from the identity, if the resource is imported by identity
+func (ri *ResourceIdentity) importer(next schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m any) ([]*schema.ResourceData, error) {
+		if d.Id() == "" {
+			identity, err := d.Identity()
+			if err != nil {
+				return nil, err
+			}
+			parts := []string{}
+			for _, attr := range ri.Attributes {
+				v, ok := identity.GetOk(attr)
+				if !ok {
+					return nil, fmt.Errorf("identity attribute %s must be set", attr)
+				}
+				parts = append(parts, v.(string))
+			}
+			d.SetId(strings.Join(parts, ri.Separator))
+		}
+		return next(ctx, d, m)
+	}
+}
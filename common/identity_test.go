@@ -0,0 +1,197 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func identityTestResource(identity *ResourceIdentity) *schema.Resource {
+	return Resource{
+		Schema: map[string]*schema.Schema{
+			"catalog_name": {Type: schema.TypeString, Required: true},
+			"name":         {Type: schema.TypeString, Required: true},
+		},
+		Identity: identity,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			return nil
+		},
+	}.ToResource()
+}
+
+func TestResourceIdentitySchema(t *testing.T) {
+	r := identityTestResource(NewSeparatedIdentity(".", "catalog_name", "name"))
+	require.NotNil(t, r.Identity)
+	assert.NoError(t, r.Identity.InternalIdentityValidate())
+	s := r.Identity.SchemaMap()
+	assert.Len(t, s, 2)
+	assert.True(t, s["catalog_name"].RequiredForImport)
+	assert.True(t, s["name"].RequiredForImport)
+}
+
+func TestResourceIdentity_SetOnRead(t *testing.T) {
+	r := identityTestResource(NewSeparatedIdentity(".", "catalog_name", "name"))
+	d := r.Data(&terraform.InstanceState{ID: "main.default"})
+	diags := r.ReadContext(context.Background(), d, &DatabricksClient{})
+	assert.False(t, diags.HasError())
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	assert.Equal(t, "main", identity.Get("catalog_name"))
+	assert.Equal(t, "default", identity.Get("name"))
+}
+
+func TestResourceIdentity_UnexpectedID(t *testing.T) {
+	r := identityTestResource(NewSeparatedIdentity(".", "catalog_name", "name"))
+	d := r.Data(&terraform.InstanceState{ID: "main"})
+	diags := r.ReadContext(context.Background(), d, &DatabricksClient{})
+	assert.False(t, diags.HasError())
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	assert.Equal(t, "", identity.Get("catalog_name"))
+}
+
+func TestResourceIdentity_Split(t *testing.T) {
+	parts, ok := NewSeparatedIdentity(".", "catalog_name", "schema_name", "name").Split("main.default.files.v2")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"main", "default", "files.v2"}, parts)
+	_, ok = NewSeparatedIdentity(".", "catalog_name", "name").Split("main")
+	assert.False(t, ok)
+	parts, ok = NewIdentity("path").Split("/a/b")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"/a/b"}, parts)
+}
+
+func TestResourceIdentity_ImportByIdentity(t *testing.T) {
+	r := identityTestResource(NewPairID("catalog_name", "name").Identity())
+	d := r.Data(&terraform.InstanceState{Identity: map[string]string{
+		"catalog_name": "main",
+		"name":         "default",
+	}})
+	imported, err := r.Importer.StateContext(context.Background(), d, &DatabricksClient{})
+	require.NoError(t, err)
+	require.Len(t, imported, 1)
+	assert.Equal(t, "main|default", imported[0].Id())
+}
+
+func TestResourceIdentity_ImportByID(t *testing.T) {
+	r := identityTestResource(NewIdentity("name"))
+	d := r.Data(&terraform.InstanceState{ID: "main"})
+	imported, err := r.Importer.StateContext(context.Background(), d, &DatabricksClient{})
+	require.NoError(t, err)
+	assert.Equal(t, "main", imported[0].Id())
+	identity, err := imported[0].Identity()
+	require.NoError(t, err)
+	assert.Equal(t, "main", identity.Get("name"))
+}
+
+func TestResourceIdentity_ImportMissingAttribute(t *testing.T) {
+	r := identityTestResource(NewSeparatedIdentity(".", "catalog_name", "name"))
+	d := r.Data(&terraform.InstanceState{Identity: map[string]string{
+		"catalog_name": "main",
+	}})
+	_, err := r.Importer.StateContext(context.Background(), d, &DatabricksClient{})
+	assert.EqualError(t, err, "identity attribute name must be set")
+}
+
+func TestResourceIdentity_SetOnReadWithWarning(t *testing.T) {
+	r := Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Required: true},
+		},
+		Identity: NewIdentity("name"),
+		Read: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			return &Warning{Summary: "changed outside of Terraform"}
+		},
+	}.ToResource()
+	d := r.Data(&terraform.InstanceState{ID: "main"})
+	diags := r.ReadContext(context.Background(), d, &DatabricksClient{})
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	assert.Equal(t, "main", identity.Get("name"))
+}
+
+// renameThroughProvider renames the resource with the update of the gRPC server of the SDK, which validates
+// changes of the identity
+func renameThroughProvider(t *testing.T, identity *ResourceIdentity) []*tfprotov5.Diagnostic {
+	r := Resource{
+		Schema: map[string]*schema.Schema{
+			"catalog_name": {Type: schema.TypeString, Required: true},
+			"name":         {Type: schema.TypeString, Required: true},
+		},
+		Identity: identity,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			return nil
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			d.SetId(d.Get("catalog_name").(string) + "." + d.Get("name").(string))
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			return nil
+		},
+	}.ToResource()
+	p := &schema.Provider{ResourcesMap: map[string]*schema.Resource{"databricks_test": r}}
+	p.SetMeta(&DatabricksClient{})
+	server := schema.NewGRPCProviderServer(p)
+
+	stateType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":           tftypes.String,
+		"catalog_name": tftypes.String,
+		"name":         tftypes.String,
+	}}
+	dynamicValue := func(typ tftypes.Type, values map[string]tftypes.Value) *tfprotov5.DynamicValue {
+		dv, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, values))
+		require.NoError(t, err)
+		return &dv
+	}
+	state := func(id, name string) *tfprotov5.DynamicValue {
+		return dynamicValue(stateType, map[string]tftypes.Value{
+			"id":           tftypes.NewValue(tftypes.String, id),
+			"catalog_name": tftypes.NewValue(tftypes.String, "main"),
+			"name":         tftypes.NewValue(tftypes.String, name),
+		})
+	}
+	identityType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"catalog_name": tftypes.String,
+		"name":         tftypes.String,
+	}}
+	resp, err := server.ApplyResourceChange(context.Background(), &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:     "databricks_test",
+		PriorState:   state("main.a", "a"),
+		PlannedState: state("main.a", "b"),
+		Config: dynamicValue(stateType, map[string]tftypes.Value{
+			"id":           tftypes.NewValue(tftypes.String, nil),
+			"catalog_name": tftypes.NewValue(tftypes.String, "main"),
+			"name":         tftypes.NewValue(tftypes.String, "b"),
+		}),
+		PlannedIdentity: &tfprotov5.ResourceIdentityData{
+			IdentityData: dynamicValue(identityType, map[string]tftypes.Value{
+				"catalog_name": tftypes.NewValue(tftypes.String, "main"),
+				"name":         tftypes.NewValue(tftypes.String, "a"),
+			}),
+		},
+	})
+	require.NoError(t, err)
+	return resp.Diagnostics
+}
+
+func TestResourceIdentity_Rename(t *testing.T) {
+	diags := renameThroughProvider(t, NewSeparatedIdentity(".", "catalog_name", "name"))
+	require.Len(t, diags, 1)
+	assert.Contains(t, diags[0].Summary, "Unexpected Identity Change")
+
+	diags = renameThroughProvider(t, NewSeparatedIdentity(".", "catalog_name", "name").Mutable())
+	assert.Len(t, diags, 0)
+}
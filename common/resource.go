@@ -3,6 +3,7 @@ package common
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
@@ -29,6 +30,7 @@ type Resource struct {
 	DeprecationMessage              string
 	Importer                        *schema.ResourceImporter
 	CanSkipReadAfterCreateAndUpdate func(d *schema.ResourceData) bool
+	Identity                        *ResourceIdentity
 }
 
 func nicerError(ctx context.Context, err error, action string) error {
@@ -92,18 +94,20 @@ func (r Resource) ToResource() *schema.Resource {
 		update = func(ctx context.Context, d *schema.ResourceData,
 			m any) diag.Diagnostics {
 			c := m.(*DatabricksClient)
-			if err := recoverable(r.Update)(ctx, d, c); err != nil {
+			err := traced("update", recoverable(r.Update))(ctx, d, c)
+			warnings := warningDiagnostics(err)
+			if err != nil && warnings == nil {
 				err = nicerError(ctx, err, "update")
 				return diag.FromErr(err)
 			}
 			if r.CanSkipReadAfterCreateAndUpdate != nil && r.CanSkipReadAfterCreateAndUpdate(d) {
-				return nil
+				return append(warnings, r.setIdentity(d)...)
 			}
-			if err := recoverable(r.Read)(ctx, d, c); err != nil {
+			if err := traced("read", recoverable(r.Read))(ctx, d, c); err != nil {
 				err = nicerError(ctx, err, "read")
 				return diag.FromErr(err)
 			}
-			return nil
+			return append(warnings, r.setIdentity(d)...)
 		}
 	} else {
 		// set ForceNew to all attributes with CRD
@@ -114,7 +118,8 @@ func (r Resource) ToResource() *schema.Resource {
 			head := queue[0]
 			queue = queue[1:]
 			for _, v := range head.Schema {
-				if v.Computed {
+				// write-only attributes can't force replacement, their `_wo_version` pair does
+				if v.Computed || v.WriteOnly {
 					continue
 				}
 				if nested, ok := v.Elem.(*schema.Resource); ok {
@@ -133,7 +138,7 @@ func (r Resource) ToResource() *schema.Resource {
 		m any) diag.Diagnostics {
 		return func(ctx context.Context, d *schema.ResourceData,
 			m any) diag.Diagnostics {
-			err := recoverable(r.Read)(ctx, d, m.(*DatabricksClient))
+			err := traced("read", recoverable(r.Read))(ctx, d, m.(*DatabricksClient))
 			// TODO: https://github.com/databricks/terraform-provider-databricks/issues/2021
 			if ignoreMissing && apierr.IsMissing(err) {
 				log.Printf("[INFO] %s[id=%s] is removed on backend",
@@ -141,11 +146,14 @@ func (r Resource) ToResource() *schema.Resource {
 				d.SetId("")
 				return nil
 			}
+			if warnings := warningDiagnostics(err); warnings != nil {
+				return append(warnings, r.setIdentity(d)...)
+			}
 			if err != nil {
 				err = nicerError(ctx, err, "read")
 				return diag.FromErr(err)
 			}
-			return nil
+			return r.setIdentity(d)
 		}
 	}
 	resource := &schema.Resource{
@@ -162,24 +170,25 @@ func (r Resource) ToResource() *schema.Resource {
 	if r.Create != nil {
 		resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 			c := m.(*DatabricksClient)
-			err := recoverable(r.Create)(ctx, d, c)
-			if err != nil {
+			err := traced("create", recoverable(r.Create))(ctx, d, c)
+			warnings := warningDiagnostics(err)
+			if err != nil && warnings == nil {
 				err = nicerError(ctx, err, "create")
 				return diag.FromErr(err)
 			}
 			if r.CanSkipReadAfterCreateAndUpdate != nil && r.CanSkipReadAfterCreateAndUpdate(d) {
-				return nil
+				return append(warnings, r.setIdentity(d)...)
 			}
-			if err = recoverable(r.Read)(ctx, d, c); err != nil {
+			if err = traced("read", recoverable(r.Read))(ctx, d, c); err != nil {
 				err = nicerError(ctx, err, "read")
 				return diag.FromErr(err)
 			}
-			return nil
+			return append(warnings, r.setIdentity(d)...)
 		}
 	}
 	if r.Delete != nil {
 		resource.DeleteContext = func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-			err := recoverable(r.Delete)(ctx, d, m.(*DatabricksClient))
+			err := traced("delete", recoverable(r.Delete))(ctx, d, m.(*DatabricksClient))
 			if apierr.IsMissing(err) {
 				log.Printf("[INFO] %s[id=%s] is removed on backend",
 					ResourceName.GetOrUnknown(ctx), d.Id())
@@ -207,9 +216,38 @@ func (r Resource) ToResource() *schema.Resource {
 			},
 		}
 	}
+	if r.Identity != nil {
+		resource.Identity = r.Identity.toSchema()
+		resource.ResourceBehavior.MutableIdentity = r.Identity.mutable
+		resource.Importer = &schema.ResourceImporter{
+			StateContext: r.Identity.importer(resource.Importer.StateContext),
+		}
+	}
 	return resource
 }
 
+// warningDiagnostics converts *Warning into a warning diagnostic, and returns nil for other errors
+func warningDiagnostics(err error) diag.Diagnostics {
+	var warning *Warning
+	if !errors.As(err, &warning) {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  warning.Summary,
+			Detail:   warning.Detail,
+		},
+	}
+}
+
+func (r Resource) setIdentity(d *schema.ResourceData) diag.Diagnostics {
+	if r.Identity == nil {
+		return nil
+	}
+	return diag.FromErr(r.Identity.setIdentity(d))
+}
+
 func MustCompileKeyRE(name string) *regexp.Regexp {
 	regexFromName := strings.ReplaceAll(name, ".", "\\.")
 	regexFromName = strings.ReplaceAll(regexFromName, ".0", ".\\d+")
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -127,6 +128,32 @@ func TestUpdateDontSkipRead(t *testing.T) {
 	assert.Equal(t, 2, d.Get("foo"))
 }
 
+func TestCreateWithWarning(t *testing.T) {
+	r := Resource{
+		Create: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			d.SetId("abc")
+			return &Warning{Summary: "check skipped"}
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			return d.Set("foo", 2)
+		},
+		Schema: map[string]*schema.Schema{
+			"foo": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}.ToResource()
+	d := r.TestResourceData()
+	diags := r.CreateContext(context.Background(), d, &DatabricksClient{})
+	assert.False(t, diags.HasError())
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "check skipped", diags[0].Summary)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, 2, d.Get("foo"))
+}
+
 func TestHTTP404TriggersResourceRemovalForReadAndDelete(t *testing.T) {
 	nope := func(ctx context.Context,
 		d *schema.ResourceData,
@@ -12,15 +12,21 @@ import (
 
 var timeoutRegex = regexp.MustCompile(`request timed out after .* of inactivity`)
 
+// RetryOnTimeout calls the given method until it either succeeds or returns an error that isn't
+// a timeout. If the context has a [RetryPolicy], its retryable errors, backoff and number of retries are used.
 func RetryOnTimeout[T any](ctx context.Context, f func(context.Context) (*T, error)) (*T, error) {
-	r := retries.New[T](retries.WithRetryFunc(func(err error) bool {
+	isTimeout := func(err error) bool {
 		msg := err.Error()
 		isTimeout := timeoutRegex.MatchString(msg)
 		if isTimeout {
 			logger.Debugf(ctx, "Retrying due to timeout: %s", msg)
 		}
 		return isTimeout
-	}))
+	}
+	if policy, ok := retryPolicyFromContext(ctx); ok {
+		return retryWithPolicy(ctx, policy, isTimeout, f)
+	}
+	r := retries.New[T](retries.WithRetryFunc(isTimeout))
 	return r.Run(ctx, func(ctx context.Context) (*T, error) {
 		return f(ctx)
 	})
@@ -28,15 +34,20 @@ func RetryOnTimeout[T any](ctx context.Context, f func(context.Context) (*T, err
 
 // RetryOn504 returns a [retries.Retrier] that calls the given method
 // until it either succeeds or returns an error that is different from
-// [apierr.ErrDeadlineExceeded].
+// [apierr.ErrDeadlineExceeded]. If the context has a [RetryPolicy], its retryable errors, backoff and
+// number of retries are used.
 func RetryOn504[T any](ctx context.Context, f func(context.Context) (*T, error)) (*T, error) {
-	r := retries.New[T](retries.WithTimeout(-1), retries.WithRetryFunc(func(err error) bool {
+	is504 := func(err error) bool {
 		if !errors.Is(err, apierr.ErrDeadlineExceeded) {
 			return false
 		}
 		logger.Debugf(ctx, "Retrying on error 504")
 		return true
-	}))
+	}
+	if policy, ok := retryPolicyFromContext(ctx); ok {
+		return retryWithPolicy(ctx, policy, is504, f)
+	}
+	r := retries.New[T](retries.WithTimeout(-1), retries.WithRetryFunc(is504))
 	return r.Run(ctx, func(ctx context.Context) (*T, error) {
 		return f(ctx)
 	})
@@ -0,0 +1,380 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/config"
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultRetryMinBackoff = 1 * time.Second
+	defaultRetryMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy configures client-side retries and rate limiting of requests to Databricks REST API,
+// on top of the retries done by the Go SDK. Zero value keeps the default behavior of the Go SDK.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries of a single request. Zero means that requests are
+	// retried until the retry timeout of the client.
+	MaxRetries int
+
+	// MinBackoff is the delay before the first retry, that is doubled for every next retry.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between retries.
+	MaxBackoff time.Duration
+
+	// HostRateLimit is the maximum number of requests per second to the same host, shared by
+	// all clients in the process. Zero means no limit other than `rate_limit` of every client.
+	HostRateLimit int
+
+	// RetryableStatusCodes are HTTP status codes retried in addition to 429 and 503.
+	RetryableStatusCodes []int
+
+	// RetryableMessages are substrings of error messages that make the request retried.
+	RetryableMessages []string
+}
+
+// IsEnabled returns true if any of the retry policy settings is configured
+func (p RetryPolicy) IsEnabled() bool {
+	return p.retries() || p.HostRateLimit > 0
+}
+
+// retries returns true if the policy changes how requests are retried
+func (p RetryPolicy) retries() bool {
+	return p.MaxRetries > 0 || p.MinBackoff > 0 || p.MaxBackoff > 0 ||
+		len(p.RetryableStatusCodes) > 0 || len(p.RetryableMessages) > 0
+}
+
+// WithDefaults returns a copy of the policy where unset settings are taken from defaults.
+// Retryable status codes and messages are merged.
+func (p RetryPolicy) WithDefaults(defaults RetryPolicy) RetryPolicy {
+	if p.MaxRetries == 0 {
+		p.MaxRetries = defaults.MaxRetries
+	}
+	if p.MinBackoff == 0 {
+		p.MinBackoff = defaults.MinBackoff
+	}
+	if p.MaxBackoff == 0 {
+		p.MaxBackoff = defaults.MaxBackoff
+	}
+	if p.HostRateLimit == 0 {
+		p.HostRateLimit = defaults.HostRateLimit
+	}
+	for _, code := range defaults.RetryableStatusCodes {
+		if !slices.Contains(p.RetryableStatusCodes, code) {
+			p.RetryableStatusCodes = append(p.RetryableStatusCodes, code)
+		}
+	}
+	for _, msg := range defaults.RetryableMessages {
+		if !slices.Contains(p.RetryableMessages, msg) {
+			p.RetryableMessages = append(p.RetryableMessages, msg)
+		}
+	}
+	return p
+}
+
+// RetryPolicyFromEnv reads the retry policy from `DATABRICKS_MAX_RETRIES`, `DATABRICKS_RETRY_MIN_BACKOFF_SECONDS`,
+// `DATABRICKS_RETRY_MAX_BACKOFF_SECONDS`, `DATABRICKS_HOST_RATE_LIMIT`, `DATABRICKS_RETRYABLE_STATUS_CODES` and
+// `DATABRICKS_RETRYABLE_ERROR_MESSAGES` environment variables. Lists are comma-separated.
+func RetryPolicyFromEnv() (RetryPolicy, error) {
+	var p RetryPolicy
+	var err error
+	intFromEnv := func(name string) int {
+		v, ok := os.LookupEnv(name)
+		if !ok || v == "" || err != nil {
+			return 0
+		}
+		i, parseErr := strconv.Atoi(strings.TrimSpace(v))
+		if parseErr != nil {
+			err = fmt.Errorf("%s: %w", name, parseErr)
+		}
+		return i
+	}
+	p.MaxRetries = intFromEnv("DATABRICKS_MAX_RETRIES")
+	p.MinBackoff = time.Duration(intFromEnv("DATABRICKS_RETRY_MIN_BACKOFF_SECONDS")) * time.Second
+	p.MaxBackoff = time.Duration(intFromEnv("DATABRICKS_RETRY_MAX_BACKOFF_SECONDS")) * time.Second
+	p.HostRateLimit = intFromEnv("DATABRICKS_HOST_RATE_LIMIT")
+	for _, v := range splitEnvList("DATABRICKS_RETRYABLE_STATUS_CODES") {
+		code, parseErr := strconv.Atoi(v)
+		if parseErr != nil {
+			return p, fmt.Errorf("DATABRICKS_RETRYABLE_STATUS_CODES: %w", parseErr)
+		}
+		p.RetryableStatusCodes = append(p.RetryableStatusCodes, code)
+	}
+	p.RetryableMessages = splitEnvList("DATABRICKS_RETRYABLE_ERROR_MESSAGES")
+	if err != nil {
+		return p, err
+	}
+	return p, p.Validate()
+}
+
+func splitEnvList(name string) (res []string) {
+	for _, v := range strings.Split(os.Getenv(name), ",") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// Validate checks that the retry policy settings are consistent
+func (p RetryPolicy) Validate() error {
+	if p.MaxRetries < 0 {
+		return fmt.Errorf("max_retries must not be negative")
+	}
+	if p.MinBackoff < 0 || p.MaxBackoff < 0 {
+		return fmt.Errorf("retry backoff must not be negative")
+	}
+	if p.MaxBackoff > 0 && p.MinBackoff > p.MaxBackoff {
+		return fmt.Errorf("retry_min_backoff_seconds must not be greater than retry_max_backoff_seconds")
+	}
+	if p.HostRateLimit < 0 {
+		return fmt.Errorf("host_rate_limit must not be negative")
+	}
+	for _, code := range p.RetryableStatusCodes {
+		if code < 400 || code > 599 {
+			return fmt.Errorf("retryable status code %d must be between 400 and 599", code)
+		}
+	}
+	return nil
+}
+
+func (p RetryPolicy) minBackoff() time.Duration {
+	if p.MinBackoff == 0 {
+		return defaultRetryMinBackoff
+	}
+	return p.MinBackoff
+}
+
+func (p RetryPolicy) maxBackoff() time.Duration {
+	if p.MaxBackoff == 0 {
+		return max(defaultRetryMaxBackoff, p.minBackoff())
+	}
+	return p.MaxBackoff
+}
+
+// Backoff returns the delay before the given retry, starting from zero
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.minBackoff()
+	for i := 0; i < attempt && delay < p.maxBackoff(); i++ {
+		delay *= 2
+	}
+	return min(delay, p.maxBackoff())
+}
+
+// canRetry returns true if the request can be retried once more after the given number of retries
+func (p RetryPolicy) canRetry(attempt int) bool {
+	return p.MaxRetries == 0 || attempt < p.MaxRetries
+}
+
+// IsRetryableStatusCode returns true for 429, 503 and the configured HTTP status codes
+func (p RetryPolicy) IsRetryableStatusCode(code int) bool {
+	return code == http.StatusTooManyRequests || code == http.StatusServiceUnavailable ||
+		slices.Contains(p.RetryableStatusCodes, code)
+}
+
+// IsRetryableMessage returns true if the message contains any of the configured retryable messages
+func (p RetryPolicy) IsRetryableMessage(message string) bool {
+	for _, m := range p.RetryableMessages {
+		if strings.Contains(message, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRetryableError returns true for API errors with retryable status codes and errors with retryable messages
+func (p RetryPolicy) IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var apiErr *apierr.APIError
+	if errors.As(err, &apiErr) && p.IsRetryableStatusCode(apiErr.StatusCode) {
+		return true
+	}
+	return p.IsRetryableMessage(err.Error())
+}
+
+// Configure wraps the HTTP transport of the SDK config, so that all requests made with clients created
+// from this config are retried and rate limited according to the policy
+func (p RetryPolicy) Configure(cfg *config.Config) {
+	if !p.IsEnabled() {
+		return
+	}
+	cfg.HTTPTransport = &retryTransport{
+		policy:       p,
+		next:         nextTransport(cfg),
+		retryTimeout: time.Duration(cfg.RetryTimeoutSeconds) * time.Second,
+	}
+}
+
+// nextTransport returns the HTTP transport to be wrapped, which is the default one of the SDK, if not set
+func nextTransport(cfg *config.Config) http.RoundTripper {
+	if cfg.HTTPTransport != nil {
+		return cfg.HTTPTransport
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.InsecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	return transport
+}
+
+type retryPolicyContextKey struct{}
+
+// InContext returns a context, that makes [RetryOnTimeout] and [RetryOn504] follow the policy
+func (p RetryPolicy) InContext(ctx context.Context) context.Context {
+	if !p.retries() {
+		return ctx
+	}
+	return context.WithValue(ctx, retryPolicyContextKey{}, p)
+}
+
+func retryPolicyFromContext(ctx context.Context) (RetryPolicy, bool) {
+	p, ok := ctx.Value(retryPolicyContextKey{}).(RetryPolicy)
+	return p, ok
+}
+
+// retryWithPolicy calls the function until it succeeds, returns an error that isn't retryable,
+// or runs out of retries
+func retryWithPolicy[T any](ctx context.Context, p RetryPolicy, isRetryable func(error) bool,
+	f func(context.Context) (*T, error)) (*T, error) {
+	for attempt := 0; ; attempt++ {
+		res, err := f(ctx)
+		if err == nil {
+			return res, nil
+		}
+		if !(isRetryable(err) || p.IsRetryableError(err)) || !p.canRetry(attempt) {
+			return res, err
+		}
+		delay := p.Backoff(attempt)
+		log.Printf("[DEBUG] Retrying in %s after error: %s", delay, err)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+var (
+	hostRateLimiters   = map[string]*rate.Limiter{}
+	hostRateLimitersMu sync.Mutex
+)
+
+// hostRateLimiter returns the limiter shared by all clients making requests to the host.
+// If clients configure different limits, the lowest one is used.
+func hostRateLimiter(host string, limit int) *rate.Limiter {
+	hostRateLimitersMu.Lock()
+	defer hostRateLimitersMu.Unlock()
+	l, ok := hostRateLimiters[host]
+	if !ok {
+		l = rate.NewLimiter(rate.Limit(limit), 1)
+		hostRateLimiters[host] = l
+	} else if rate.Limit(limit) < l.Limit() {
+		l.SetLimit(rate.Limit(limit))
+	}
+	return l
+}
+
+// retryTransport retries requests with retryable responses and limits the rate of requests per host
+type retryTransport struct {
+	policy       RetryPolicy
+	next         http.RoundTripper
+	retryTimeout time.Duration
+}
+
+// SkipRetryOnIO keeps HTTP fixtures of unit tests working through the wrapper
+func (t *retryTransport) SkipRetryOnIO() bool {
+	skippable, ok := t.next.(interface {
+		SkipRetryOnIO() bool
+	})
+	return ok && skippable.SkipRetryOnIO()
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.SkipRetryOnIO() {
+		return t.next.RoundTrip(req)
+	}
+	start := time.Now()
+	for attempt := 0; ; attempt++ {
+		if t.policy.HostRateLimit > 0 {
+			err := hostRateLimiter(req.URL.Host, t.policy.HostRateLimit).Wait(req.Context())
+			if err != nil {
+				return nil, err
+			}
+		}
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || !t.policy.retries() {
+			return resp, err
+		}
+		retryable, message, err := t.isRetryable(resp)
+		if err != nil || !retryable {
+			return resp, err
+		}
+		if req.Body != nil && req.GetBody == nil {
+			// the body is consumed and can't be sent again, so it's up to the SDK to retry
+			return resp, nil
+		}
+		if t.retryTimeout > 0 && time.Since(start) > t.retryTimeout {
+			return resp, nil
+		}
+		if !t.policy.canRetry(attempt) {
+			resp.Body.Close()
+			return nil, fmt.Errorf("giving up after %d retries of %s %s: %d %s",
+				attempt, req.Method, req.URL.Path, resp.StatusCode, message)
+		}
+		resp.Body.Close()
+		delay := t.policy.Backoff(attempt)
+		if retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			delay = max(delay, min(time.Duration(retryAfter)*time.Second, t.policy.maxBackoff()))
+		}
+		log.Printf("[DEBUG] Retrying %s %s in %s after %d response: %s",
+			req.Method, req.URL.Path, delay, resp.StatusCode, message)
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// isRetryable checks the status code and the body of the response. The body is buffered, so that
+// it can still be read by the caller.
+func (t *retryTransport) isRetryable(resp *http.Response) (bool, string, error) {
+	if resp.StatusCode < 400 {
+		return false, "", nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return false, "", err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	message := string(body)
+	retryable := t.policy.IsRetryableStatusCode(resp.StatusCode) || t.policy.IsRetryableMessage(message)
+	return retryable, message, nil
+}
@@ -0,0 +1,208 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{MinBackoff: 2 * time.Second, MaxBackoff: 10 * time.Second}
+	assert.Equal(t, 2*time.Second, p.Backoff(0))
+	assert.Equal(t, 4*time.Second, p.Backoff(1))
+	assert.Equal(t, 8*time.Second, p.Backoff(2))
+	assert.Equal(t, 10*time.Second, p.Backoff(3))
+	assert.Equal(t, 10*time.Second, p.Backoff(100))
+
+	assert.Equal(t, time.Second, RetryPolicy{}.Backoff(0))
+	assert.Equal(t, 30*time.Second, RetryPolicy{}.Backoff(10))
+}
+
+func TestRetryPolicyFromEnv(t *testing.T) {
+	t.Setenv("DATABRICKS_MAX_RETRIES", "3")
+	t.Setenv("DATABRICKS_RETRY_MIN_BACKOFF_SECONDS", "2")
+	t.Setenv("DATABRICKS_RETRY_MAX_BACKOFF_SECONDS", "20")
+	t.Setenv("DATABRICKS_HOST_RATE_LIMIT", "10")
+	t.Setenv("DATABRICKS_RETRYABLE_STATUS_CODES", "409, 500")
+	t.Setenv("DATABRICKS_RETRYABLE_ERROR_MESSAGES", "RESOURCE_CONFLICT,try again later")
+	p, err := RetryPolicyFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, RetryPolicy{
+		MaxRetries:           3,
+		MinBackoff:           2 * time.Second,
+		MaxBackoff:           20 * time.Second,
+		HostRateLimit:        10,
+		RetryableStatusCodes: []int{409, 500},
+		RetryableMessages:    []string{"RESOURCE_CONFLICT", "try again later"},
+	}, p)
+}
+
+func TestRetryPolicyFromEnv_Invalid(t *testing.T) {
+	t.Setenv("DATABRICKS_MAX_RETRIES", "many")
+	_, err := RetryPolicyFromEnv()
+	assert.ErrorContains(t, err, "DATABRICKS_MAX_RETRIES: strconv.Atoi")
+}
+
+func TestRetryPolicyWithDefaults(t *testing.T) {
+	p := RetryPolicy{
+		MaxRetries:        10,
+		RetryableMessages: []string{"a"},
+	}.WithDefaults(RetryPolicy{
+		MaxRetries:           4,
+		MinBackoff:           time.Second,
+		RetryableStatusCodes: []int{409},
+		RetryableMessages:    []string{"a", "b"},
+	})
+	assert.Equal(t, RetryPolicy{
+		MaxRetries:           10,
+		MinBackoff:           time.Second,
+		RetryableStatusCodes: []int{409},
+		RetryableMessages:    []string{"a", "b"},
+	}, p)
+}
+
+func TestRetryPolicyValidate(t *testing.T) {
+	assert.NoError(t, RetryPolicy{}.Validate())
+	assert.EqualError(t, RetryPolicy{MaxRetries: -1}.Validate(), "max_retries must not be negative")
+	assert.EqualError(t, RetryPolicy{MinBackoff: time.Minute, MaxBackoff: time.Second}.Validate(),
+		"retry_min_backoff_seconds must not be greater than retry_max_backoff_seconds")
+	assert.EqualError(t, RetryPolicy{RetryableStatusCodes: []int{302}}.Validate(),
+		"retryable status code 302 must be between 400 and 599")
+}
+
+func TestRetryPolicyIsRetryableError(t *testing.T) {
+	p := RetryPolicy{
+		RetryableStatusCodes: []int{409},
+		RetryableMessages:    []string{"RESOURCE_CONFLICT"},
+	}
+	assert.True(t, p.IsRetryableError(&apierr.APIError{StatusCode: 409}))
+	assert.True(t, p.IsRetryableError(&apierr.APIError{StatusCode: 429}))
+	assert.True(t, p.IsRetryableError(fmt.Errorf("RESOURCE_CONFLICT: busy")))
+	assert.False(t, p.IsRetryableError(&apierr.APIError{StatusCode: 400, Message: "bad"}))
+	assert.False(t, p.IsRetryableError(nil))
+}
+
+func TestRetryPolicyConfigure_Disabled(t *testing.T) {
+	cfg := &config.Config{}
+	RetryPolicy{}.Configure(cfg)
+	assert.Nil(t, cfg.HTTPTransport)
+}
+
+// retryServer responds with the given status code and message until the number of failures is reached
+func retryServer(t *testing.T, failures int32, statusCode int, message string) (*httptest.Server, *atomic.Int32) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			body, _ := io.ReadAll(r.Body)
+			assert.Equal(t, `{"a":1}`, string(body))
+		}
+		if attempts.Add(1) <= failures {
+			w.WriteHeader(statusCode)
+			fmt.Fprintf(w, `{"error_code":"ERROR","message":"%s"}`, message)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	}))
+	t.Cleanup(server.Close)
+	return server, &attempts
+}
+
+func retryPolicyClient(p RetryPolicy) *http.Client {
+	cfg := &config.Config{}
+	p.Configure(cfg)
+	return &http.Client{Transport: cfg.HTTPTransport}
+}
+
+func TestRetryTransport_RetryableStatusCode(t *testing.T) {
+	server, attempts := retryServer(t, 2, 409, "conflict")
+	client := retryPolicyClient(RetryPolicy{
+		MinBackoff:           time.Millisecond,
+		RetryableStatusCodes: []int{409},
+	})
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"a":1}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, int32(3), attempts.Load())
+}
+
+func TestRetryTransport_RetryableMessage(t *testing.T) {
+	server, attempts := retryServer(t, 1, 400, "RESOURCE_CONFLICT: try again")
+	client := retryPolicyClient(RetryPolicy{
+		MinBackoff:        time.Millisecond,
+		RetryableMessages: []string{"RESOURCE_CONFLICT"},
+	})
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"a":1}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, int32(2), attempts.Load())
+}
+
+func TestRetryTransport_MaxRetries(t *testing.T) {
+	server, attempts := retryServer(t, 10, 429, "too many requests")
+	client := retryPolicyClient(RetryPolicy{
+		MaxRetries: 2,
+		MinBackoff: time.Millisecond,
+	})
+	_, err := client.Post(server.URL, "application/json", strings.NewReader(`{"a":1}`))
+	assert.ErrorContains(t, err, "giving up after 2 retries of POST : 429")
+	assert.Equal(t, int32(3), attempts.Load())
+}
+
+func TestRetryTransport_NotRetryable(t *testing.T) {
+	server, attempts := retryServer(t, 10, 400, "bad request")
+	client := retryPolicyClient(RetryPolicy{
+		MinBackoff:           time.Millisecond,
+		RetryableStatusCodes: []int{409},
+	})
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"a":1}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, 400, resp.StatusCode)
+	assert.Contains(t, string(body), "bad request")
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func TestRetryTransport_ContextCanceled(t *testing.T) {
+	server, _ := retryServer(t, 10, 503, "unavailable")
+	client := retryPolicyClient(RetryPolicy{
+		MinBackoff: time.Hour,
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, "GET", server.URL, nil)
+	_, err := client.Do(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestHostRateLimiter(t *testing.T) {
+	l := hostRateLimiter("rate-limit.test", 10)
+	assert.Same(t, l, hostRateLimiter("rate-limit.test", 20))
+	assert.Equal(t, float64(10), float64(l.Limit()))
+	hostRateLimiter("rate-limit.test", 5)
+	assert.Equal(t, float64(5), float64(l.Limit()))
+}
+
+func TestRetryTransport_HostRateLimit(t *testing.T) {
+	server, attempts := retryServer(t, 0, 200, "")
+	client := retryPolicyClient(RetryPolicy{HostRateLimit: 1000})
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	assert.Equal(t, int32(3), attempts.Load())
+}
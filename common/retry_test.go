@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/experimental/mocks"
@@ -132,3 +133,36 @@ func TestRetryOn504_error504ThenSuccess(t *testing.T) {
 	assert.Equal(t, gotRes, wantRes)
 	assert.Equal(t, gotCalls, wantCalls)
 }
+
+func TestRetryOnTimeout_RetryPolicy(t *testing.T) {
+	ctx := RetryPolicy{
+		MaxRetries:        2,
+		MinBackoff:        time.Millisecond,
+		RetryableMessages: []string{"RESOURCE_CONFLICT"},
+	}.InContext(context.Background())
+	attempts := 0
+	_, err := RetryOnTimeout(ctx, func(ctx context.Context) (*workspace.ObjectInfo, error) {
+		attempts++
+		return nil, errors.New("RESOURCE_CONFLICT: try again")
+	})
+	assert.EqualError(t, err, "RESOURCE_CONFLICT: try again")
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryOn504_RetryPolicy(t *testing.T) {
+	ctx := RetryPolicy{
+		MaxRetries: 5,
+		MinBackoff: time.Millisecond,
+	}.InContext(context.Background())
+	attempts := 0
+	res, err := RetryOn504(ctx, func(ctx context.Context) (*workspace.ObjectInfo, error) {
+		attempts++
+		if attempts < 3 {
+			return nil, apierr.ErrDeadlineExceeded
+		}
+		return &workspace.ObjectInfo{Path: "/a"}, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "/a", res.Path)
+	assert.Equal(t, 3, attempts)
+}
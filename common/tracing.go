@@ -0,0 +1,153 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"github.com/databricks/databricks-sdk-go/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/databricks/terraform-provider-databricks"
+
+// tracingEnabled is set once spans are exported, so that HTTP transports are instrumented only then
+var tracingEnabled atomic.Bool
+
+// Tracer returns the tracer for spans of the provider. Spans are dropped, unless tracing is set up.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// tracingRequested returns true if an OTLP endpoint is configured with the standard environment variables
+func tracingRequested() bool {
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") {
+		return false
+	}
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// otlpProtocol returns the OTLP protocol configured with the standard environment variables
+func otlpProtocol() string {
+	if protocol := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL"); protocol != "" {
+		return protocol
+	}
+	if protocol := os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL"); protocol != "" {
+		return protocol
+	}
+	return "http/protobuf"
+}
+
+// SetupTracing exports spans over OTLP, if an endpoint is configured with the standard OTEL_*
+// environment variables, and returns the function flushing the remaining spans on exit.
+// Without an endpoint it does nothing.
+func SetupTracing(ctx context.Context) (func(context.Context) error, error) {
+	if !tracingRequested() {
+		return func(context.Context) error { return nil }, nil
+	}
+	var exporter *otlptrace.Exporter
+	var err error
+	switch protocol := otlpProtocol(); protocol {
+	case "grpc":
+		exporter, err = otlptracegrpc.New(ctx)
+	case "http/protobuf":
+		exporter, err = otlptracehttp.New(ctx)
+	default:
+		return nil, fmt.Errorf("unsupported OTLP protocol: %s", protocol)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot create OTLP exporter: %w", err)
+	}
+	return SetupTracingWithExporter(ctx, exporter)
+}
+
+// SetupTracingWithExporter makes spans of the provider go to the given exporter, which is useful
+// for testing with an in-memory exporter.
+func SetupTracingWithExporter(ctx context.Context, exporter sdktrace.SpanExporter) (func(context.Context) error, error) {
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			semconv.ServiceName("terraform-provider-databricks"),
+			semconv.ServiceVersion(Version())),
+		// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence
+		resource.WithFromEnv())
+	if err != nil {
+		return nil, fmt.Errorf("cannot create tracing resource: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res))
+	otel.SetTracerProvider(provider)
+	tracingEnabled.Store(true)
+	return func(ctx context.Context) error {
+		tracingEnabled.Store(false)
+		return provider.Shutdown(ctx)
+	}, nil
+}
+
+// ConfigureTracing wraps the HTTP transport of the config, so that every HTTP call is a child span
+// of the operation that made it. It does nothing if tracing isn't set up.
+func ConfigureTracing(cfg *config.Config) {
+	if !tracingEnabled.Load() {
+		return
+	}
+	cfg.HTTPTransport = otelhttp.NewTransport(nextTransport(cfg))
+}
+
+// StartSpan starts a span of the provider, which has to be ended by the caller
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records the error, if any, and ends the span
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// tracedResourceID returns the ID of the resource, if there's resource data. Some tests call data
+// sources without it.
+func tracedResourceID(d *schema.ResourceData) string {
+	if d == nil {
+		return ""
+	}
+	return d.Id()
+}
+
+// traced wraps the CRUD function of a resource with a span named after the resource and operation
+func traced(operation string, cb func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error) func(
+	ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+	return func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) (err error) {
+		name := ResourceName.GetOrUnknown(ctx)
+		kind := "resource"
+		if IsData.GetOrUnknown(ctx) == "yes" {
+			kind = "data"
+		}
+		ctx, span := StartSpan(ctx, fmt.Sprintf("databricks_%s %s", name, operation),
+			attribute.String("databricks.resource.type", "databricks_"+name),
+			attribute.String("databricks.resource.kind", kind),
+			attribute.String("databricks.resource.id", tracedResourceID(d)),
+			attribute.String("databricks.operation", operation),
+			attribute.String("databricks.sdk", Sdk.GetOrUnknown(ctx)))
+		defer func() {
+			span.SetAttributes(attribute.String("databricks.resource.id", tracedResourceID(d)))
+			EndSpan(span, err)
+		}()
+		return cb(ctx, d, c)
+	}
+}
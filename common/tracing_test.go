@@ -0,0 +1,159 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// keptSpansExporter keeps spans after shutdown, which resets the in-memory exporter
+type keptSpansExporter struct {
+	*tracetest.InMemoryExporter
+}
+
+func (keptSpansExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func setupTestTracing(t *testing.T) func() tracetest.SpanStubs {
+	exporter := tracetest.NewInMemoryExporter()
+	shutdown, err := SetupTracingWithExporter(context.Background(), keptSpansExporter{exporter})
+	require.NoError(t, err)
+	flushed := false
+	flush := func() tracetest.SpanStubs {
+		if !flushed {
+			require.NoError(t, shutdown(context.Background()))
+			flushed = true
+		}
+		return exporter.GetSpans()
+	}
+	t.Cleanup(func() { flush() })
+	return flush
+}
+
+func spanAttribute(span tracetest.SpanStub, key string) string {
+	for _, attr := range span.Attributes {
+		if string(attr.Key) == key {
+			return attr.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func TestSetupTracingWithoutEndpoint(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+	shutdown, err := SetupTracing(context.Background())
+	require.NoError(t, err)
+	assert.False(t, tracingEnabled.Load())
+	assert.NoError(t, shutdown(context.Background()))
+
+	cfg := &config.Config{}
+	ConfigureTracing(cfg)
+	assert.Nil(t, cfg.HTTPTransport)
+}
+
+func TestSetupTracingDisabled(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://localhost:4318")
+	t.Setenv("OTEL_SDK_DISABLED", "true")
+	assert.False(t, tracingRequested())
+}
+
+func TestSetupTracingUnsupportedProtocol(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://localhost:4318")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL", "http/json")
+	_, err := SetupTracing(context.Background())
+	assert.EqualError(t, err, "unsupported OTLP protocol: http/json")
+}
+
+func TestResourceOperationsAreTraced(t *testing.T) {
+	flush := setupTestTracing(t)
+	r := Resource{
+		Schema: map[string]*schema.Schema{},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			d.SetId("abc")
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			return fmt.Errorf("nope")
+		},
+	}.ToResource()
+	ctx := context.WithValue(context.Background(), ResourceName, "bar")
+	d := r.TestResourceData()
+	diags := r.CreateContext(ctx, d, &DatabricksClient{})
+	require.False(t, diags.HasError())
+	diags = r.DeleteContext(ctx, d, &DatabricksClient{})
+	require.True(t, diags.HasError())
+
+	spans := flush()
+	require.Len(t, spans, 3)
+	assert.Equal(t, "databricks_bar create", spans[0].Name)
+	assert.Equal(t, "abc", spanAttribute(spans[0], "databricks.resource.id"))
+	assert.Equal(t, "create", spanAttribute(spans[0], "databricks.operation"))
+	assert.Equal(t, codes.Unset, spans[0].Status.Code)
+	assert.Equal(t, "databricks_bar read", spans[1].Name)
+	assert.Equal(t, "databricks_bar delete", spans[2].Name)
+	assert.Equal(t, codes.Error, spans[2].Status.Code)
+	assert.Equal(t, "nope", spans[2].Status.Description)
+}
+
+func TestHTTPCallsAreChildSpans(t *testing.T) {
+	flush := setupTestTracing(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+	cfg := &config.Config{}
+	ConfigureTracing(cfg)
+	require.NotNil(t, cfg.HTTPTransport)
+	client := &http.Client{Transport: cfg.HTTPTransport}
+
+	ctx, span := StartSpan(context.Background(), "databricks_bar read", attribute.String("databricks.operation", "read"))
+	req, err := http.NewRequestWithContext(ctx, "GET", server.URL+"/api/2.0/clusters/get", nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	EndSpan(span, nil)
+
+	spans := flush()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "HTTP GET", spans[0].Name)
+	assert.Equal(t, spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID())
+}
+
+func TestSpansAreExportedOnShutdown(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	shutdown, err := SetupTracingWithExporter(context.Background(), keptSpansExporter{exporter})
+	require.NoError(t, err)
+	r := Resource{
+		Schema: map[string]*schema.Schema{},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			return nil
+		},
+	}.ToResource()
+	ctx := context.WithValue(context.Background(), ResourceName, "bar")
+	diags := r.ReadContext(ctx, r.TestResourceData(), &DatabricksClient{})
+	require.False(t, diags.HasError())
+
+	// operations don't wait for the export
+	assert.Len(t, exporter.GetSpans(), 0)
+
+	require.NoError(t, shutdown(context.Background()))
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "databricks_bar read", spans[0].Name)
+}
@@ -0,0 +1,83 @@
+package common
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// WriteOnlyName returns the name of the write-only variant of an attribute
+func WriteOnlyName(name string) string {
+	return name + "_wo"
+}
+
+// WriteOnlyVersionName returns the name of the attribute, that triggers an update of the write-only attribute
+func WriteOnlyVersionName(name string) string {
+	return name + "_wo_version"
+}
+
+// AddWriteOnly adds `<name>_wo` variant of a sensitive string attribute, that Terraform 1.11+ never stores in the plan
+// or the state, and `<name>_wo_version`, that has to be changed to send the new value, as Terraform can't detect
+// changes of write-only attributes. The original attribute stays for compatibility and becomes optional.
+func AddWriteOnly(s map[string]*schema.Schema, name string) {
+	attr := s[name]
+	wo, version := WriteOnlyName(name), WriteOnlyVersionName(name)
+	if attr.Required {
+		attr.Required = false
+		attr.Optional = true
+		attr.ExactlyOneOf = []string{name, wo}
+	} else {
+		attr.ConflictsWith = append(attr.ConflictsWith, wo)
+	}
+	s[wo] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ValidateFunc:  attr.ValidateFunc,
+		ConflictsWith: []string{name},
+	}
+	if attr.ExactlyOneOf != nil {
+		s[wo].ConflictsWith = nil
+		s[wo].ExactlyOneOf = attr.ExactlyOneOf
+	}
+	s[version] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ForceNew:     attr.ForceNew,
+		RequiredWith: []string{wo},
+	}
+}
+
+// GetWriteOnly returns the value of the write-only variant of the attribute, if it's configured, or the value of
+// the attribute itself
+func GetWriteOnly(d *schema.ResourceData, name string) string {
+	if v := GetWriteOnlyValue(d, name); v != "" {
+		return v
+	}
+	return d.Get(name).(string)
+}
+
+// GetWriteOnlyValue returns the value of `<name>_wo` from the configuration. Write-only values are only available in
+// the configuration during apply, as they are removed from the plan.
+func GetWriteOnlyValue(d *schema.ResourceData, name string) string {
+	wo := WriteOnlyName(name)
+	if v := getWriteOnlyFromConfig(d.GetRawConfig(), wo); v != "" {
+		return v
+	}
+	// unit tests don't have the raw configuration
+	if v, ok := d.GetOk(wo); ok {
+		return v.(string)
+	}
+	return ""
+}
+
+func getWriteOnlyFromConfig(config cty.Value, wo string) string {
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(wo) {
+		return ""
+	}
+	v := config.GetAttr(wo)
+	if v.IsNull() || !v.IsKnown() || v.Type() != cty.String {
+		return ""
+	}
+	return v.AsString()
+}
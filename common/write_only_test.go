@@ -0,0 +1,94 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddWriteOnly_Required(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"value": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+	AddWriteOnly(s, "value")
+
+	assert.False(t, s["value"].Required)
+	assert.True(t, s["value"].Optional)
+	assert.Equal(t, []string{"value", "value_wo"}, s["value"].ExactlyOneOf)
+
+	require.Contains(t, s, "value_wo")
+	assert.True(t, s["value_wo"].WriteOnly)
+	assert.True(t, s["value_wo"].Sensitive)
+	assert.Nil(t, s["value_wo"].ConflictsWith)
+	assert.Equal(t, []string{"value", "value_wo"}, s["value_wo"].ExactlyOneOf)
+	assert.NotNil(t, s["value_wo"].ValidateFunc)
+
+	require.Contains(t, s, "value_wo_version")
+	assert.Equal(t, schema.TypeInt, s["value_wo_version"].Type)
+	assert.True(t, s["value_wo_version"].ForceNew)
+	assert.Equal(t, []string{"value_wo"}, s["value_wo_version"].RequiredWith)
+
+	assert.NoError(t, schema.InternalMap(s).InternalValidate(schema.InternalMap(s)))
+}
+
+func TestAddWriteOnly_Optional(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"value": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+	AddWriteOnly(s, "value")
+
+	assert.True(t, s["value"].Optional)
+	assert.Equal(t, []string{"value_wo"}, s["value"].ConflictsWith)
+	assert.Equal(t, []string{"value"}, s["value_wo"].ConflictsWith)
+	assert.Nil(t, s["value_wo"].ExactlyOneOf)
+	assert.False(t, s["value_wo_version"].ForceNew)
+
+	assert.NoError(t, schema.InternalMap(s).InternalValidate(schema.InternalMap(s)))
+}
+
+func TestGetWriteOnly(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"value": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+	AddWriteOnly(s, "value")
+	r := &schema.Resource{Schema: s}
+
+	d := r.TestResourceData()
+	d.Set("value", "plain")
+	assert.Equal(t, "plain", GetWriteOnly(d, "value"))
+	assert.Equal(t, "", GetWriteOnlyValue(d, "value"))
+
+	d = r.TestResourceData()
+	d.Set("value_wo", "secret")
+	assert.Equal(t, "secret", GetWriteOnly(d, "value"))
+	assert.Equal(t, "secret", GetWriteOnlyValue(d, "value"))
+}
+
+func TestGetWriteOnlyFromConfig(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"value":            cty.NullVal(cty.String),
+		"value_wo":         cty.StringVal("secret"),
+		"value_wo_version": cty.NumberIntVal(1),
+	})
+	assert.Equal(t, "secret", getWriteOnlyFromConfig(config, "value_wo"))
+	assert.Equal(t, "", getWriteOnlyFromConfig(config, "value"))
+	assert.Equal(t, "", getWriteOnlyFromConfig(config, "missing_wo"))
+	assert.Equal(t, "", getWriteOnlyFromConfig(cty.NullVal(config.Type()), "value_wo"))
+	assert.Equal(t, "", getWriteOnlyFromConfig(cty.UnknownVal(config.Type()), "value_wo"))
+}
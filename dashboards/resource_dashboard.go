@@ -42,6 +42,7 @@ func (Dashboard) CustomizeSchema(s *common.CustomizableSchema) *common.Customiza
 	s.SchemaPath("path").SetComputed()
 	s.SchemaPath("update_time").SetComputed()
 	s.SchemaPath("md5").SetComputed()
+	s.AddDriftFields()
 
 	// ForceNew fields
 	s.SchemaPath("parent_path").SetCustomSuppressDiff(common.WorkspacePathPrefixDiffSuppress).SetForceNew()
@@ -119,6 +120,8 @@ func ResourceDashboard() common.Resource {
 			if err != nil {
 				return err
 			}
+			drift := common.NewDrift(d, "create_time", "etag", "lifecycle_state", "path", "update_time",
+				"md5", "dashboard_change_detected")
 			dashboard := dashboards.GetDashboardRequest{
 				DashboardId: d.Id(),
 			}
@@ -135,13 +138,19 @@ func ResourceDashboard() common.Resource {
 			}
 
 			d.Set("dashboard_change_detected", (resp.Etag != d.Get("etag").(string)))
-			return common.StructToData(resp, dashboardSchema, d)
+			err = common.StructToData(resp, dashboardSchema, d)
+			if err != nil {
+				return err
+			}
+			// the Lakeview API doesn't report who edited the dashboard
+			return drift.Check(ctx, d, nil)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
 				return err
 			}
+			common.ResetDrift(d)
 			var dashboard dashboards.Dashboard
 			common.DataToStructPointer(d, dashboardSchema, &dashboard)
 			dashboard.DashboardId = d.Id()
@@ -36,7 +36,7 @@ func maybeAddQuoteCharacter(s string) string {
 	return s
 }
 
-func genTraversalTokens(sr *resourceApproximation, pick string) hcl.Traversal {
+func (ic *importContext) genTraversalTokens(sr *resourceApproximation, pick string) hcl.Traversal {
 	if sr.Mode == "data" {
 		return hcl.Traversal{
 			hcl.TraverseRoot{Name: "data"},
@@ -45,10 +45,22 @@ func genTraversalTokens(sr *resourceApproximation, pick string) hcl.Traversal {
 			hcl.TraverseAttr{Name: pick},
 		}
 	}
+	return append(ic.resourceTraversal(sr.Type, sr.Name), hcl.TraverseAttr{Name: pick})
+}
+
+// resourceTraversal returns the address of the given resource, taking into account
+// that it could be generated as an instance of a `for_each` resource
+func (ic *importContext) resourceTraversal(rtype, name string) hcl.Traversal {
+	if group := ic.forEachGroupOf(rtype, name); group != nil {
+		return hcl.Traversal{
+			hcl.TraverseRoot{Name: rtype},
+			hcl.TraverseAttr{Name: group.Name},
+			hcl.TraverseIndex{Key: cty.StringVal(name)},
+		}
+	}
 	return hcl.Traversal{
-		hcl.TraverseRoot{Name: sr.Type},
-		hcl.TraverseAttr{Name: sr.Name},
-		hcl.TraverseAttr{Name: pick},
+		hcl.TraverseRoot{Name: rtype},
+		hcl.TraverseAttr{Name: name},
 	}
 }
 
@@ -101,7 +113,7 @@ func (ic *importContext) Find(value, attr string, ref reference, origResource *r
 			!ic.isIgnoredResourceApproximation(sr) {
 			log.Printf("[DEBUG] Finished direct lookup for reference for resource %s, attr='%s', value='%s', ref=%v. Found: type=%s name=%s",
 				ref.Resource, attr, value, ref, sr.Type, sr.Name)
-			return matchValue, genTraversalTokens(sr, attr), sr.Mode == "data"
+			return matchValue, ic.genTraversalTokens(sr, attr), sr.Mode == "data"
 		}
 		if ref.MatchType != MatchCaseInsensitive { // for case-insensitive matching we'll try iteration
 			log.Printf("[DEBUG] Finished direct lookup for reference for resource %s, attr='%s', value='%s', ref=%v. Not found",
@@ -116,7 +128,7 @@ func (ic *importContext) Find(value, attr string, ref reference, origResource *r
 				!ic.isIgnoredResourceApproximation(sr) {
 				log.Printf("[DEBUG] Finished direct lookup by key %s for reference for resource %s, attr='%s', value='%s', ref=%v. Found: type=%s name=%s",
 					ref.ExtraLookupKey, ref.Resource, attr, value, ref, sr.Type, sr.Name)
-				return extraKeyValue.(string), genTraversalTokens(sr, attr), sr.Mode == "data"
+				return extraKeyValue.(string), ic.genTraversalTokens(sr, attr), sr.Mode == "data"
 			}
 		}
 	}
@@ -161,7 +173,7 @@ func (ic *importContext) Find(value, attr string, ref reference, origResource *r
 			}
 			log.Printf("[DEBUG] Finished searching for reference for resource %s, attr='%s', value='%s', ref=%v. Found: type=%s name=%s",
 				ref.Resource, attr, value, ref, sr.Type, sr.Name)
-			return origValue, genTraversalTokens(sr, attr), sr.Mode == "data"
+			return origValue, ic.genTraversalTokens(sr, attr), sr.Mode == "data"
 		}
 	}
 	if ref.MatchType == MatchLongestPrefix && maxPrefixResource != nil &&
@@ -169,7 +181,7 @@ func (ic *importContext) Find(value, attr string, ref reference, origResource *r
 		!ic.isIgnoredResourceApproximation(maxPrefixResource) {
 		log.Printf("[DEBUG] Finished searching longest prefix for reference for resource %s, attr='%s', value='%s', ref=%v. Found: type=%s name=%s",
 			ref.Resource, attr, value, ref, maxPrefixResource.Type, maxPrefixResource.Name)
-		return maxPrefixOrigValue, genTraversalTokens(maxPrefixResource, attr), maxPrefixResource.Mode == "data"
+		return maxPrefixOrigValue, ic.genTraversalTokens(maxPrefixResource, attr), maxPrefixResource.Mode == "data"
 	}
 	log.Printf("[DEBUG] Finished searching for reference for resource %s, pick=%s, ref=%v. Not found", ref.Resource, attr, ref)
 	return "", nil, false
@@ -397,17 +409,29 @@ func (ic *importContext) dataToHcl(i importable, path []string,
 				Type:  hclsyntax.TokenOBrack,
 				Bytes: []byte{'['},
 			})
-			for i, dr := range notIgnoredResources {
-				if i > 0 {
+			dependencies := map[string]struct{}{}
+			for _, dr := range notIgnoredResources {
+				name := ic.ResourceName(dr)
+				// `depends_on` can't refer to instances of `for_each` resources, so the whole resource is used
+				if group := ic.forEachGroupOf(dr.Resource, name); group != nil {
+					name = group.Name
+				}
+				traversal := hcl.Traversal{
+					hcl.TraverseRoot{Name: dr.Resource},
+					hcl.TraverseAttr{Name: name},
+				}
+				dependency := string(hclwrite.TokensForTraversal(traversal).Bytes())
+				if _, exists := dependencies[dependency]; exists {
+					continue
+				}
+				if len(dependencies) > 0 {
 					toks = append(toks, &hclwrite.Token{
 						Type:  hclsyntax.TokenComma,
 						Bytes: []byte{','},
 					})
 				}
-				toks = append(toks, hclwrite.TokensForTraversal(hcl.Traversal{
-					hcl.TraverseRoot{Name: dr.Resource},
-					hcl.TraverseAttr{Name: ic.ResourceName(dr)},
-				})...)
+				dependencies[dependency] = struct{}{}
+				toks = append(toks, hclwrite.TokensForTraversal(traversal)...)
 			}
 			toks = append(toks, &hclwrite.Token{
 				Type:  hclsyntax.TokenCBrack,
@@ -610,6 +634,7 @@ func (ic *importContext) generateAndWriteResources(sh *os.File) {
 			writersWaitGroup.Done()
 		}()
 	}
+	ic.groupForEachResources(resources)
 	// submit all extracted resources...
 	for i, r := range resources {
 		ic.waitGroup.Add(1)
@@ -619,6 +644,9 @@ func (ic *importContext) generateAndWriteResources(sh *os.File) {
 		}
 	}
 	ic.waitGroup.Wait()
+	// resources grouped into `for_each` resources are written only after all of them are generated
+	ic.generateForEachResources(resourceWriters, nativeImportChan)
+	ic.waitGroup.Wait()
 	// close all channels
 	close(shellImportChan)
 	close(nativeImportChan)
@@ -656,6 +684,19 @@ func (ic *importContext) processSingleResource(resourcesChan resourceChannel,
 		f := hclwrite.NewEmptyFile()
 		log.Printf("[TRACE] Generating %s: %s", r.Resource, r.Name)
 		body := f.Body()
+		if group := ic.forEachGroupOf(r.Resource, r.Name); group != nil {
+			err = ic.dataToHcl(ir, []string{}, ic.Resources[r.Resource], r, body)
+			if err == nil {
+				err = ic.addForEachItem(group, r, body)
+			}
+			if err != nil {
+				log.Printf("[ERROR] error generating %s as part of %s.%s: %s", r, group.Resource, group.Name, err.Error())
+			} else {
+				generated = generated + 1
+			}
+			ic.waitGroup.Done()
+			continue
+		}
 		if ir.Body != nil {
 			err = ir.Body(ic, body, r)
 			if err != nil {
@@ -682,7 +723,7 @@ func (ic *importContext) processSingleResource(resourcesChan resourceChannel,
 				BlockName:    generateBlockFullName(body.Blocks()[0]),
 			}
 			if r.Mode != "data" && ic.Resources[r.Resource].Importer != nil {
-				writeData.ImportCommand = r.ImportCommand(ic)
+				writeData.ImportCommands = []string{r.ImportCommand(ic)}
 				if ic.nativeImportSupported { // generate import block for native import
 					imp := hclwrite.NewEmptyFile()
 					imoBlock := imp.Body().AppendNewBlock("import", []string{})
@@ -716,6 +757,287 @@ func (ic *importContext) processSingleResource(resourcesChan resourceChannel,
 	log.Printf("[DEBUG] processed resources: %d, generated: %d, ignored: %d", processed, generated, ignored)
 }
 
+// forEachGroup is a `for_each` resource that replaces individual blocks of the same resource type
+type forEachGroup struct {
+	Resource string
+	Name     string
+	Service  string
+	items    map[string]forEachItem
+}
+
+type forEachItem struct {
+	ID         string
+	Attributes map[string]hclwrite.Tokens
+	// nested blocks by block type, they are generated as `dynamic` blocks over lists of objects
+	Blocks    map[string][]map[string]hclwrite.Tokens
+	DependsOn []hclwrite.Tokens
+}
+
+func (g *forEachGroup) localName() string {
+	return g.Resource + "_" + g.Name
+}
+
+func (ic *importContext) forEachGroupOf(rtype, name string) *forEachGroup {
+	return ic.forEachGroups[generateResourceName(rtype, name)]
+}
+
+// groupForEachResources assigns resources of types enabled with `-forEach` to the `for_each` resources
+// they will be generated into. It must be called before generation, so references could be resolved
+func (ic *importContext) groupForEachResources(resources []*resource) {
+	ic.forEachGroups = map[string]*forEachGroup{}
+	groups := map[string]*forEachGroup{}
+	for _, r := range resources {
+		if _, enabled := ic.forEachResources[r.Resource]; !enabled || r.Mode == "data" {
+			continue
+		}
+		ir := ic.Importables[r.Resource]
+		if ir.ForEachGroup == nil || ir.Body != nil || (ir.Ignore != nil && ir.Ignore(ic, r)) {
+			continue
+		}
+		groupName := ir.ForEachGroup(ic, r)
+		if groupName == "" {
+			groupName = "this"
+		}
+		name := ic.ResourceName(&resource{Resource: r.Resource, Name: groupName})
+		groupId := generateResourceName(r.Resource, name)
+		group, exists := groups[groupId]
+		if !exists {
+			group = &forEachGroup{
+				Resource: r.Resource,
+				Name:     name,
+				Service:  ir.Service,
+				items:    map[string]forEachItem{},
+			}
+			groups[groupId] = group
+		}
+		ic.forEachGroups[generateResourceName(r.Resource, r.Name)] = group
+	}
+	log.Printf("[INFO] Grouped %d resources into %d for_each resources", len(ic.forEachGroups), len(groups))
+}
+
+// addForEachItem stores attributes generated for a single resource, so they could be written as
+// an element of the local map that is used by the `for_each` resource
+func (ic *importContext) addForEachItem(group *forEachGroup, r *resource, body *hclwrite.Body) error {
+	item := forEachItem{
+		ID:         r.ID,
+		Attributes: map[string]hclwrite.Tokens{},
+		Blocks:     map[string][]map[string]hclwrite.Tokens{},
+	}
+	for _, block := range body.Blocks() {
+		if len(block.Body().Blocks()) > 0 {
+			return fmt.Errorf("blocks nested into %s aren't supported in for_each resources", block.Type())
+		}
+		attributes := map[string]hclwrite.Tokens{}
+		for name, attr := range block.Body().Attributes() {
+			attributes[name] = attr.Expr().BuildTokens(nil)
+		}
+		item.Blocks[block.Type()] = append(item.Blocks[block.Type()], attributes)
+	}
+	for name, attr := range body.Attributes() {
+		tokens := attr.Expr().BuildTokens(nil)
+		if name != "depends_on" {
+			item.Attributes[name] = tokens
+			continue
+		}
+		// split the list into individual references
+		dependency := hclwrite.Tokens{}
+		for _, token := range tokens {
+			switch token.Type {
+			case hclsyntax.TokenOBrack, hclsyntax.TokenNewline:
+			case hclsyntax.TokenComma, hclsyntax.TokenCBrack:
+				if len(dependency) > 0 {
+					item.DependsOn = append(item.DependsOn, dependency)
+					dependency = hclwrite.Tokens{}
+				}
+			default:
+				dependency = append(dependency, token)
+			}
+		}
+	}
+	ic.forEachMutex.Lock()
+	defer ic.forEachMutex.Unlock()
+	group.items[r.Name] = item
+	return nil
+}
+
+// generateForEachResources writes a local map and a `for_each` resource for every group of resources,
+// together with import commands for all its instances
+func (ic *importContext) generateForEachResources(writerChannels map[string]dataWriteChannel,
+	nativeImportChannel importWriteChannel) {
+	groups := map[string]*forEachGroup{}
+	for _, group := range ic.forEachGroups {
+		groups[generateResourceName(group.Resource, group.Name)] = group
+	}
+	groupIds := make([]string, 0, len(groups))
+	for groupId := range groups {
+		groupIds = append(groupIds, groupId)
+	}
+	sort.Strings(groupIds)
+	for _, groupId := range groupIds {
+		group := groups[groupId]
+		if len(group.items) == 0 {
+			continue
+		}
+		keys := make([]string, 0, len(group.items))
+		for key := range group.items {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		attributeCounts := map[string]int{}
+		blockCounts := map[string]int{}
+		blockAttributeCounts := map[string]map[string]int{}
+		blockSizes := map[string]int{}
+		dependencies := map[string]struct{}{}
+		dependsOn := []hclwrite.Tokens{}
+		localItems := make([]hclwrite.ObjectAttrTokens, 0, len(keys))
+		for _, key := range keys {
+			item := group.items[key]
+			attributes := forEachObjectAttributes(item.Attributes, attributeCounts)
+			for _, blockType := range sortedAttributeNames(item.Blocks) {
+				blockCounts[blockType] = blockCounts[blockType] + 1
+				if _, exists := blockAttributeCounts[blockType]; !exists {
+					blockAttributeCounts[blockType] = map[string]int{}
+				}
+				elements := make([]hclwrite.Tokens, 0, len(item.Blocks[blockType]))
+				for _, blockAttributes := range item.Blocks[blockType] {
+					blockSizes[blockType] = blockSizes[blockType] + 1
+					elements = append(elements, hclwrite.TokensForObject(
+						forEachObjectAttributes(blockAttributes, blockAttributeCounts[blockType])))
+				}
+				attributes = append(attributes, hclwrite.ObjectAttrTokens{
+					Name:  hclwrite.TokensForIdentifier(blockType),
+					Value: hclwrite.TokensForTuple(elements),
+				})
+			}
+			localItems = append(localItems, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(cty.StringVal(key)),
+				Value: hclwrite.TokensForObject(attributes),
+			})
+			for _, dependency := range item.DependsOn {
+				dependencyStr := string(dependency.Bytes())
+				if _, exists := dependencies[dependencyStr]; !exists {
+					dependencies[dependencyStr] = struct{}{}
+					dependsOn = append(dependsOn, dependency)
+				}
+			}
+		}
+
+		f := hclwrite.NewEmptyFile()
+		body := f.Body()
+		body.AppendNewBlock("locals", []string{}).Body().SetAttributeRaw(group.localName(),
+			hclwrite.TokensForObject(localItems))
+		body.AppendNewline()
+		resourceBody := body.AppendNewBlock("resource", []string{group.Resource, group.Name}).Body()
+		resourceBody.SetAttributeTraversal("for_each", hcl.Traversal{
+			hcl.TraverseRoot{Name: "local"},
+			hcl.TraverseAttr{Name: group.localName()},
+		})
+		eachValue := hcl.Traversal{
+			hcl.TraverseRoot{Name: "each"},
+			hcl.TraverseAttr{Name: "value"},
+		}
+		setForEachAttributes(resourceBody, eachValue, attributeCounts, len(keys))
+		for _, blockType := range sortedAttributeNames(blockCounts) {
+			dynamicBody := resourceBody.AppendNewBlock("dynamic", []string{blockType}).Body()
+			if blockCounts[blockType] == len(keys) {
+				dynamicBody.SetAttributeTraversal("for_each", append(eachValue, hcl.TraverseAttr{Name: blockType}))
+			} else {
+				// block that isn't present in all instances
+				dynamicBody.SetAttributeRaw("for_each", hclwrite.TokensForFunctionCall("lookup",
+					hclwrite.TokensForTraversal(eachValue),
+					hclwrite.TokensForValue(cty.StringVal(blockType)),
+					hclwrite.TokensForTuple(nil)))
+			}
+			setForEachAttributes(dynamicBody.AppendNewBlock("content", []string{}).Body(), hcl.Traversal{
+				hcl.TraverseRoot{Name: blockType},
+				hcl.TraverseAttr{Name: "value"},
+			}, blockAttributeCounts[blockType], blockSizes[blockType])
+		}
+		if len(dependsOn) > 0 {
+			resourceBody.SetAttributeRaw("depends_on", hclwrite.TokensForTuple(dependsOn))
+		}
+		formatted := hclwrite.Format(f.Bytes())
+		formatted = []byte(ic.regexFix(string(formatted), ic.hclFixes))
+		writeData := &resourceWriteData{
+			ResourceBody: string(formatted),
+			BlockName:    groupId,
+		}
+		if ic.Resources[group.Resource].Importer != nil {
+			for _, key := range keys {
+				id := group.items[key].ID
+				writeData.ImportCommands = append(writeData.ImportCommands, group.importCommand(ic, key, id))
+				if ic.nativeImportSupported {
+					imp := hclwrite.NewEmptyFile()
+					impBlock := imp.Body().AppendNewBlock("import", []string{})
+					impBlock.Body().SetAttributeValue("id", cty.StringVal(id))
+					impBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+						hcl.TraverseRoot{Name: group.Resource},
+						hcl.TraverseAttr{Name: group.Name},
+						hcl.TraverseIndex{Key: cty.StringVal(key)},
+					})
+					ic.waitGroup.Add(1)
+					nativeImportChannel <- string(hclwrite.Format(imp.Bytes()))
+				}
+			}
+		}
+		ch, exists := writerChannels[group.Service]
+		if exists {
+			ic.waitGroup.Add(1)
+			ch <- writeData
+		} else {
+			log.Printf("[WARN] can't find a channel for service: %s, resource: %s", group.Service, groupId)
+		}
+		log.Printf("[DEBUG] Generated %s with %d instances", groupId, len(keys))
+	}
+}
+
+// forEachObjectAttributes converts attributes of a single instance into attributes of an object in the local map,
+// and counts how many instances have every attribute set
+func forEachObjectAttributes(attributes map[string]hclwrite.Tokens, counts map[string]int) []hclwrite.ObjectAttrTokens {
+	result := make([]hclwrite.ObjectAttrTokens, 0, len(attributes))
+	for _, name := range sortedAttributeNames(attributes) {
+		counts[name] = counts[name] + 1
+		result = append(result, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier(name),
+			Value: attributes[name],
+		})
+	}
+	return result
+}
+
+// setForEachAttributes generates attributes that take their values from the given object, i.e. `each.value`
+func setForEachAttributes(body *hclwrite.Body, value hcl.Traversal, counts map[string]int, total int) {
+	for _, name := range sortedAttributeNames(counts) {
+		if counts[name] == total {
+			body.SetAttributeTraversal(name, append(value, hcl.TraverseAttr{Name: name}))
+		} else {
+			// optional attribute that isn't set for all instances
+			body.SetAttributeRaw(name, hclwrite.TokensForFunctionCall("lookup",
+				hclwrite.TokensForTraversal(value),
+				hclwrite.TokensForValue(cty.StringVal(name)),
+				hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType))))
+		}
+	}
+}
+
+func (g *forEachGroup) importCommand(ic *importContext, key, id string) string {
+	m := ""
+	if ic.Module != "" {
+		m = ic.Module + "."
+	}
+	return fmt.Sprintf(`terraform import '%s%s.%s["%s"]' "%s"`, m, g.Resource, g.Name, key, id)
+}
+
+func sortedAttributeNames[V any](attributes map[string]V) []string {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	// the same order as in dataToHcl
+	sort.Sort(sort.Reverse(sort.StringSlice(names)))
+	return names
+}
+
 func extractResourceIdFromImportBlock(block *hclwrite.Block) string {
 	if block.Type() != "import" {
 		log.Print("[WARN] it's not an import block!")
@@ -870,9 +1192,9 @@ func generateBlockFullName(block *hclwrite.Block) string {
 }
 
 type resourceWriteData struct {
-	BlockName     string
-	ResourceBody  string
-	ImportCommand string
+	BlockName      string
+	ResourceBody   string
+	ImportCommands []string
 }
 
 type dataWriteChannel chan *resourceWriteData
@@ -920,9 +1242,9 @@ func (ic *importContext) handleResourceWrite(generatedFile string, ch dataWriteC
 				_, err = tf.WriteString(f.ResourceBody)
 				if err == nil {
 					newResources[f.BlockName] = struct{}{}
-					if f.ImportCommand != "" {
+					for _, importCommand := range f.ImportCommands {
 						ic.waitGroup.Add(1)
-						importChan <- f.ImportCommand
+						importChan <- importCommand
 					}
 					log.Printf("[DEBUG] finished writing resource body for %s", f.BlockName)
 				} else {
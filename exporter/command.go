@@ -1,6 +1,7 @@
 package exporter
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -77,13 +78,35 @@ func (ic *importContext) interactivePrompts() string {
 func Run(args ...string) error {
 	log.SetOutput(&logLevel)
 	log.Printf("[WARN] This tooling is experimental and provided as is. It has an evolving interface, which may change or be removed in future versions of the provider.")
-	client, err := client.New(&config.Config{})
+	envRetryPolicy, err := common.RetryPolicyFromEnv()
+	if err != nil {
+		return err
+	}
+	retryPolicy = envRetryPolicy.WithDefaults(retryPolicy)
+	shutdownTracing, err := common.SetupTracing(context.Background())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			log.Printf("[WARN] Cannot export remaining spans: %s", err)
+		}
+	}()
+	cfg := &config.Config{}
+	common.ConfigureTracing(cfg)
+	envRetryPolicy.Configure(cfg)
+	client, err := client.New(cfg)
 	if err != nil {
 		return err
 	}
 	ic := newImportContext(&common.DatabricksClient{
 		DatabricksClient: client,
 	})
+	ic.Client.SetRetryPolicy(envRetryPolicy)
+	ic.Context = envRetryPolicy.InContext(ic.Context)
+	defer ic.Client.CommandContexts().Close(ic.Context)
 
 	flags := flag.NewFlagSet("exporter", flag.ExitOnError)
 	flags.StringVar(&ic.Module, "module", "",
@@ -115,6 +138,10 @@ func Run(args ...string) error {
 	flags.BoolVar(&debug, "debug", false, "Print extra debug information.")
 	flags.BoolVar(&trace, "trace", false, "Print full debug information.")
 	flags.BoolVar(&ic.mounts, "mounts", false, "List DBFS mount points.")
+	flags.StringVar(&ic.migrateMountsTo, "migrateMountsTo", "",
+		"Generate external locations and external volumes in the given <catalog>.<schema> instead of "+
+			"DBFS mount points, and write mapping of mount points to volume paths into mounts_migration.csv. "+
+			"Implies -mounts")
 	flags.BoolVar(&ic.generateDeclaration, "generateProviderDeclaration", true,
 		"Generate Databricks provider declaration.")
 	flags.BoolVar(&ic.filterDirectoriesDuringWorkspaceWalking, "filterDirectoriesDuringWorkspaceWalking", false,
@@ -139,6 +166,9 @@ func Run(args ...string) error {
 	flags.StringVar(&ic.excludeRegexStr, "excludeRegex", "", "Exclude resource names matching regex during listing operation. "+
 		"This filter applies to all resources that are getting listed, so if you want to import "+
 		"all dependencies of just one cluster, specify -listing=compute")
+	var forEach string
+	flags.StringVar(&forEach, "forEach", "", "Comma-separated list of resource types (i.e. databricks_group_member) "+
+		"that will be generated as a single `for_each` resource instead of one block per object")
 	prefix := ""
 	flags.StringVar(&prefix, "prefix", "", "Prefix that will be added to the name of all exported resources")
 	newArgs := args
@@ -162,5 +192,6 @@ func Run(args ...string) error {
 	}
 	ic.enableServices(configuredServices)
 	ic.enableListing(configuredListing)
+	ic.enableForEach(forEach)
 	return ic.Run()
 }
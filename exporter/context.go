@@ -27,6 +27,7 @@ import (
 	"github.com/databricks/terraform-provider-databricks/workspace"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 /** High level overview of importer design:
@@ -88,6 +89,9 @@ type importContext struct {
 	exportDeletedUsersAssets                bool
 	incremental                             bool
 	mounts                                  bool
+	migrateMountsTo                         string
+	mountsCatalog                           string
+	mountsSchema                            string
 	noFormat                                bool
 	nativeImportSupported                   bool
 	services                                map[string]struct{}
@@ -110,11 +114,14 @@ type importContext struct {
 	notebooksFormat                         string
 	updatedSinceStr                         string
 	updatedSinceMs                          int64
+	forEachResources                        map[string]struct{}
 
 	waitGroup *sync.WaitGroup
 
 	// TODO: protect by mutex?
 	mountMap map[string]mount
+	// maps mount point to Unity Catalog objects that replace it
+	mountMigrations map[string]mountMigration
 
 	testEmits      map[string]bool
 	testEmitsMutex sync.Mutex
@@ -168,6 +175,10 @@ type importContext struct {
 
 	tfvarsMutex sync.Mutex
 	tfvars      map[string]string
+
+	// maps resource address to the `for_each` resource it's generated into
+	forEachGroups map[string]*forEachGroup
+	forEachMutex  sync.Mutex
 }
 
 type mount struct {
@@ -310,6 +321,7 @@ func newImportContext(c *common.DatabricksClient) *importContext {
 		services:                  map[string]struct{}{},
 		listing:                   map[string]struct{}{},
 		tfvars:                    map[string]string{},
+		forEachResources:          map[string]struct{}{},
 	}
 }
 
@@ -332,7 +344,22 @@ func getLastRunString(fileName string) string {
 	return updatedSinceStr
 }
 
-func (ic *importContext) Run() error {
+// Run exports the workspace, tracing the whole run as a single span
+func (ic *importContext) Run() (err error) {
+	if ic.Context == nil {
+		return ic.run()
+	}
+	ctx, span := common.StartSpan(ic.Context, "exporter run",
+		attribute.Bool("databricks.exporter.account_level", ic.accountLevel),
+		attribute.Bool("databricks.exporter.incremental", ic.incremental))
+	ic.Context = ctx
+	defer func() {
+		common.EndSpan(span, err)
+	}()
+	return ic.run()
+}
+
+func (ic *importContext) run() error {
 	startTime := time.Now()
 	statsFileName := ic.Directory + "/exporter-run-stats.json"
 	wsObjectsFileName := ic.Directory + "/ws_objects.json"
@@ -358,6 +385,18 @@ func (ic *importContext) Run() error {
 		}
 		ic.excludeRegex = re
 	}
+	if ic.migrateMountsTo != "" {
+		parts := strings.Split(ic.migrateMountsTo, ".")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("-migrateMountsTo should be in the format <catalog>.<schema>, got '%s'",
+				ic.migrateMountsTo)
+		}
+		ic.mountsCatalog, ic.mountsSchema = parts[0], parts[1]
+		ic.mounts = true
+	}
+	if ic.incremental && len(ic.forEachResources) > 0 {
+		return fmt.Errorf("-forEach can't be used together with -incremental")
+	}
 	if ic.incremental {
 		if ic.updatedSinceStr == "" {
 			ic.updatedSinceStr = getLastRunString(statsFileName)
@@ -446,9 +485,12 @@ func (ic *importContext) Run() error {
 				ic.waitGroup.Add(1)
 				log.Printf("[DEBUG] Starting listing of workspace objects")
 				go func() {
-					if err := listWorkspaceObjects(ic); err != nil {
+					_, span := common.StartSpan(ic.Context, "exporter list workspace objects")
+					err := listWorkspaceObjects(ic)
+					if err != nil {
 						log.Printf("[ERROR] listing of workspace objects failed %s", err)
 					}
+					common.EndSpan(span, err)
 					log.Print("[DEBUG] Finished listing of workspace objects")
 					ic.waitGroup.Done()
 				}()
@@ -473,9 +515,13 @@ func (ic *importContext) Run() error {
 		}
 		ic.waitGroup.Add(1)
 		go func() {
-			if err := ir.List(ic); err != nil {
+			_, span := common.StartSpan(ic.Context, "exporter list "+resourceName,
+				attribute.String("databricks.exporter.service", ir.Service))
+			err := ir.List(ic)
+			if err != nil {
 				log.Printf("[ERROR] %s (%s service) listing failed: %s", resourceName, ir.Service, err)
 			}
+			common.EndSpan(span, err)
 			log.Printf("[DEBUG] Finished listing for service %s", resourceName)
 			ic.waitGroup.Done()
 		}()
@@ -542,7 +588,9 @@ provider "databricks" {
 		dcfile.Close()
 	}
 	//
+	_, span := common.StartSpan(ic.Context, "exporter generate")
 	ic.generateAndWriteResources(sh)
+	common.EndSpan(span, nil)
 	err = ic.generateVariables()
 	if err != nil {
 		log.Printf("[ERROR] can't write variables file: %s", err.Error())
@@ -595,6 +643,13 @@ provider "databricks" {
 		log.Printf("[ERROR] can't open %s: %s", ignoredResourcesFileName, err.Error())
 	}
 
+	if len(ic.mountMigrations) > 0 {
+		mountsReportFileName := fmt.Sprintf("%s/mounts_migration.csv", ic.Directory)
+		if err := ic.writeMountsMigrationReport(mountsReportFileName); err != nil {
+			log.Printf("[ERROR] can't write mounts migration report into %s: %s", mountsReportFileName, err.Error())
+		}
+	}
+
 	if !ic.noFormat {
 		// format generated source code
 		cmd := exec.CommandContext(context.Background(), "terraform", "fmt")
@@ -614,7 +669,12 @@ func (ic *importContext) resourceHandler(num int, resourceType string, ch resour
 	for r := range ch {
 		log.Printf("[DEBUG] channel for %s, channel size=%d got %v", resourceType, len(ch), r)
 		if r != nil {
+			_, span := common.StartSpan(ic.Context, "exporter import "+r.Resource,
+				attribute.String("databricks.resource.type", r.Resource),
+				attribute.String("databricks.resource.id", r.ID),
+				attribute.Int("databricks.exporter.queue_size", len(ch)))
 			r.ImportResource(ic)
+			common.EndSpan(span, nil)
 			log.Printf("[DEBUG] Finished importing %s, %v", resourceType, r)
 		}
 	}
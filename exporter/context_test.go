@@ -515,3 +515,237 @@ func TestGenerateDependsOn(t *testing.T) {
 	formatted := hclwrite.Format(f.Bytes())
 	assert.Contains(t, string(formatted), "depends_on   = [databricks_catalog.test, databricks_catalog.test2]")
 }
+
+func TestGenerateForEachResources(t *testing.T) {
+	ic := importContextForTest()
+	ic.Directory = fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
+	os.MkdirAll(ic.Directory, 0755)
+	defer os.RemoveAll(ic.Directory)
+	ic.nativeImportSupported = true
+	ic.variables = map[string]string{}
+	ic.enableServices("groups")
+	ic.enableForEach("databricks_group_member,databricks_group")
+	assert.Equal(t, map[string]struct{}{"databricks_group_member": {}}, ic.forEachResources)
+
+	ic.State.Append(resourceApproximation{
+		Type: "databricks_group",
+		Name: "admins",
+		Mode: "managed",
+		Instances: []instanceApproximation{
+			{Attributes: map[string]any{"id": "g1", "display_name": "admins"}},
+		},
+	})
+	group := &resource{
+		Resource: "databricks_group",
+		ID:       "g1",
+		Name:     "admins",
+		Data: ic.Resources["databricks_group"].Data(&terraform.InstanceState{
+			ID:         "g1",
+			Attributes: map[string]string{"display_name": "admins"},
+		}),
+	}
+	ic.Scope.Append(group)
+	for _, member := range []string{"u1", "u2"} {
+		ic.Scope.Append(&resource{
+			Resource: "databricks_group_member",
+			ID:       "g1|" + member,
+			Name:     "admins_" + member,
+			Data: ic.Resources["databricks_group_member"].Data(&terraform.InstanceState{
+				ID:         "g1|" + member,
+				Attributes: map[string]string{"group_id": "g1", "member_id": member},
+			}),
+			DependsOn: []*resource{group},
+		})
+	}
+	sh, err := os.Create(ic.Directory + "/import.sh")
+	require.NoError(t, err)
+	ic.generateAndWriteResources(sh)
+	sh.Close()
+
+	assert.Equal(t, `resource "databricks_group" "admins" {
+  display_name = "admins"
+}
+locals {
+  databricks_group_member_all = {
+    "admins_u1" = {
+      member_id = "u1"
+      group_id  = databricks_group.admins.id
+    }
+    "admins_u2" = {
+      member_id = "u2"
+      group_id  = databricks_group.admins.id
+    }
+  }
+}
+
+resource "databricks_group_member" "all" {
+  for_each   = local.databricks_group_member_all
+  member_id  = each.value.member_id
+  group_id   = each.value.group_id
+  depends_on = [databricks_group.admins]
+}
+`, getGeneratedFile(ic, "groups"))
+	imports, err := os.ReadFile(ic.Directory + "/import.tf")
+	require.NoError(t, err)
+	assert.Contains(t, string(imports), `to = databricks_group_member.all["admins_u1"]`)
+	assert.Contains(t, string(imports), `id = "g1|u2"`)
+	script, err := os.ReadFile(ic.Directory + "/import.sh")
+	require.NoError(t, err)
+	assert.Contains(t, string(script), `terraform import 'databricks_group_member.all["admins_u2"]' "g1|u2"`)
+
+	// references to the grouped resources are rewritten to their instances
+	traversal := ic.genTraversalTokens(&resourceApproximation{
+		Type: "databricks_group_member",
+		Name: "admins_u1",
+	}, "id")
+	assert.Equal(t, `databricks_group_member.all["admins_u1"].id`,
+		string(hclwrite.TokensForTraversal(traversal).Bytes()))
+}
+
+func TestForEachCantBeUsedWithIncremental(t *testing.T) {
+	ic := importContextForTest()
+	ic.incremental = true
+	ic.enableServices("groups")
+	ic.enableForEach("databricks_group_member")
+	err := ic.Run()
+	assert.EqualError(t, err, "-forEach can't be used together with -incremental")
+}
+
+func TestGenerateForEachSecrets(t *testing.T) {
+	ic := importContextForTest()
+	ic.Directory = fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
+	os.MkdirAll(ic.Directory, 0755)
+	defer os.RemoveAll(ic.Directory)
+	ic.variables = map[string]string{}
+	ic.enableServices("secrets")
+	ic.enableForEach("databricks_secret,databricks_secret_scope")
+	assert.Equal(t, map[string]struct{}{"databricks_secret": {}}, ic.forEachResources)
+
+	for _, key := range []string{"a", "b"} {
+		ic.Scope.Append(&resource{
+			Resource: "databricks_secret",
+			ID:       "creds|||" + key,
+			Name:     "creds_" + key,
+			Data: ic.Resources["databricks_secret"].Data(&terraform.InstanceState{
+				ID:         "creds|||" + key,
+				Attributes: map[string]string{"scope": "creds", "key": key},
+			}),
+		})
+	}
+	sh, err := os.Create(ic.Directory + "/import.sh")
+	require.NoError(t, err)
+	ic.generateAndWriteResources(sh)
+	sh.Close()
+
+	assert.Equal(t, `locals {
+  databricks_secret_creds = {
+    "creds_a" = {
+      string_value = var.string_value_creds_a
+      scope        = "creds"
+      key          = "a"
+    }
+    "creds_b" = {
+      string_value = var.string_value_creds_b
+      scope        = "creds"
+      key          = "b"
+    }
+  }
+}
+
+resource "databricks_secret" "creds" {
+  for_each     = local.databricks_secret_creds
+  string_value = each.value.string_value
+  scope        = each.value.scope
+  key          = each.value.key
+}
+`, getGeneratedFile(ic, "secrets"))
+}
+
+func TestGenerateForEachPermissionsAndUsers(t *testing.T) {
+	ic := importContextForTest()
+	ic.Directory = fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
+	os.MkdirAll(ic.Directory, 0755)
+	defer os.RemoveAll(ic.Directory)
+	ic.variables = map[string]string{}
+	ic.enableServices("access,users")
+	ic.enableForEach("databricks_permissions,databricks_user")
+
+	ic.Scope.Append(&resource{
+		Resource: "databricks_user",
+		ID:       "123",
+		Name:     "user1_123",
+		Data: ic.Resources["databricks_user"].Data(&terraform.InstanceState{
+			ID:         "123",
+			Attributes: map[string]string{"user_name": "user1@example.com", "active": "true"},
+		}),
+	})
+	permissions := func(id, name, objectField, objectId string, acl ...map[string]any) {
+		d := ic.Resources["databricks_permissions"].Data(&terraform.InstanceState{ID: id})
+		d.Set(objectField, objectId)
+		accessControl := []any{}
+		for _, ac := range acl {
+			accessControl = append(accessControl, ac)
+		}
+		d.Set("access_control", accessControl)
+		ic.Scope.Append(&resource{
+			Resource: "databricks_permissions",
+			ID:       id,
+			Name:     name,
+			Data:     d,
+		})
+	}
+	permissions("/jobs/1", "job_1", "job_id", "1",
+		map[string]any{"group_name": "admins", "permission_level": "CAN_MANAGE"},
+		map[string]any{"user_name": "user1@example.com", "permission_level": "CAN_VIEW"})
+	permissions("/clusters/abc", "cluster_abc", "cluster_id", "abc",
+		map[string]any{"group_name": "users", "permission_level": "CAN_ATTACH_TO"})
+	sh, err := os.Create(ic.Directory + "/import.sh")
+	require.NoError(t, err)
+	ic.generateAndWriteResources(sh)
+	sh.Close()
+
+	assert.Equal(t, `locals {
+  databricks_permissions_all = {
+    "cluster_abc" = {
+      cluster_id = "abc"
+      access_control = [{
+        permission_level = "CAN_ATTACH_TO"
+        group_name       = "users"
+      }]
+    }
+    "job_1" = {
+      job_id = "1"
+      access_control = [{
+        permission_level = "CAN_MANAGE"
+        group_name       = "admins"
+        }, {
+        user_name        = "user1@example.com"
+        permission_level = "CAN_VIEW"
+      }]
+    }
+  }
+}
+
+resource "databricks_permissions" "all" {
+  for_each   = local.databricks_permissions_all
+  job_id     = lookup(each.value, "job_id", null)
+  cluster_id = lookup(each.value, "cluster_id", null)
+  dynamic "access_control" {
+    for_each = each.value.access_control
+    content {
+      user_name        = lookup(access_control.value, "user_name", null)
+      permission_level = access_control.value.permission_level
+      group_name       = lookup(access_control.value, "group_name", null)
+    }
+  }
+}
+`, getGeneratedFile(ic, "access"))
+	assert.Contains(t, getGeneratedFile(ic, "users"), `resource "databricks_user" "all" {
+  for_each  = local.databricks_user_all
+  user_name = each.value.user_name
+}`)
+	script, err := os.ReadFile(ic.Directory + "/import.sh")
+	require.NoError(t, err)
+	assert.Contains(t, string(script), `terraform import 'databricks_permissions.all["job_1"]' "/jobs/1"`)
+	assert.Contains(t, string(script), `terraform import 'databricks_user.all["user1_123"]' "123"`)
+}
@@ -0,0 +1,408 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// mountMigration describes how a DBFS mount is replaced with Unity Catalog objects
+type mountMigration struct {
+	MountPoint string
+	Source     string
+	// Url is the mount source in the format accepted by Unity Catalog
+	Url string
+	// Owner is the mount point that gets an external location and a volume generated. It's
+	// different from MountPoint when the mount source is inside the source of another mount.
+	Owner string
+	// ExistingLocation is set when an existing external location already covers the source
+	ExistingLocation string
+	LocationName     string
+	CredentialName   string
+	VolumeName       string
+	VolumePath       string
+	Note             string
+}
+
+// mountCredentials has information required to match mount sources with storage credentials
+type mountCredentials struct {
+	credentials []catalog.StorageCredentialInfo
+	locations   []catalog.ExternalLocationInfo
+	// maps instance profile ARN to the ARN of its IAM role
+	roles map[string]string
+	// maps cluster ID to its instance profile ARN
+	instanceProfiles map[string]string
+}
+
+// ucStorageUrl converts mount source into the URL that is accepted by external locations
+func ucStorageUrl(source string) (string, error) {
+	u, err := url.Parse(source)
+	if err != nil {
+		return "", err
+	}
+	switch u.Scheme {
+	case "s3", "s3a", "s3n":
+		u.Scheme = "s3"
+	case "gs":
+	case "abfs", "abfss":
+		u.Scheme = "abfss"
+	case "wasb", "wasbs":
+		u.Scheme = "abfss"
+		u.Host = strings.Replace(u.Host, ".blob.", ".dfs.", 1)
+	default:
+		return "", fmt.Errorf("%s is not supported by Unity Catalog", source)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("%s is not supported by Unity Catalog", source)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawQuery = ""
+	return u.String(), nil
+}
+
+// urlCovers returns true if the child URL is the same as the parent URL or is located under it
+func urlCovers(parent, child string) bool {
+	parent = strings.TrimSuffix(parent, "/")
+	child = strings.TrimSuffix(child, "/")
+	return child == parent || strings.HasPrefix(child, parent+"/")
+}
+
+// matchStorageCredential finds the storage credential that gives access to the mount source.
+// Only AWS mounts are matched, by the IAM role of the instance profile. Mounts on GCP and Azure
+// don't keep an identity that storage credentials could be compared with, so the credential isn't
+// guessed for them. If there is no single verified match, the returned note explains why.
+func (mc *mountCredentials) matchStorageCredential(m mount, storageUrl string) (string, string) {
+	switch {
+	case strings.HasPrefix(storageUrl, "s3://"):
+	case strings.HasPrefix(storageUrl, "gs://"):
+		// clusters mount with a customer-managed service account, but storage credentials
+		// use service accounts that Databricks generates
+		return "", "storage credential must be chosen manually"
+	case strings.HasPrefix(storageUrl, "abfss://"):
+		// mounts don't keep information about service principals, so any access connector
+		// could give access to the storage account, or none of them
+		candidates := []string{}
+		for _, sc := range mc.credentials {
+			if sc.AzureManagedIdentity != nil && sc.AzureManagedIdentity.AccessConnectorId != "" {
+				candidates = append(candidates, sc.Name)
+			}
+		}
+		if len(candidates) == 0 {
+			return "", "storage credential must be chosen manually"
+		}
+		sort.Strings(candidates)
+		return "", "unverified storage credential, check access of: " + strings.Join(candidates, ", ")
+	default:
+		return "", "no matching storage credential"
+	}
+	identity := m.InstanceProfile
+	if identity == "" {
+		identity = mc.instanceProfiles[m.ClusterID]
+	}
+	if identity == "" {
+		return "", "no matching storage credential"
+	}
+	role, ok := mc.roles[identity]
+	if !ok || role == "" {
+		role = strings.Replace(identity, ":instance-profile/", ":role/", 1)
+	}
+	candidates := []string{}
+	for _, sc := range mc.credentials {
+		if sc.AwsIamRole != nil && sc.AwsIamRole.RoleArn == role {
+			candidates = append(candidates, sc.Name)
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		return "", "no matching storage credential"
+	case 1:
+		return candidates[0], ""
+	}
+	sort.Strings(candidates)
+	return "", "ambiguous storage credential, one of: " + strings.Join(candidates, ", ")
+}
+
+// loadMountCredentials fetches storage credentials, external locations and identities of
+// the mounting clusters that are used to match mount sources
+func (ic *importContext) loadMountCredentials() (*mountCredentials, error) {
+	mc := &mountCredentials{
+		roles:            map[string]string{},
+		instanceProfiles: map[string]string{},
+	}
+	var err error
+	mc.credentials, err = ic.workspaceClient.StorageCredentials.ListAll(ic.Context,
+		catalog.ListStorageCredentialsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	mc.locations, err = ic.workspaceClient.ExternalLocations.ListAll(ic.Context,
+		catalog.ListExternalLocationsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	hasS3 := false
+	for _, m := range ic.mountMap {
+		if !strings.HasPrefix(m.URL, "s3") {
+			continue
+		}
+		hasS3 = true
+		if m.ClusterID == "" || m.InstanceProfile != "" {
+			continue
+		}
+		if _, ok := mc.instanceProfiles[m.ClusterID]; ok {
+			continue
+		}
+		cluster, err := ic.workspaceClient.Clusters.GetByClusterId(ic.Context, m.ClusterID)
+		if err != nil {
+			return nil, err
+		}
+		instanceProfile := ""
+		if cluster.AwsAttributes != nil {
+			instanceProfile = cluster.AwsAttributes.InstanceProfileArn
+		}
+		mc.instanceProfiles[m.ClusterID] = instanceProfile
+	}
+	if hasS3 && ic.Client.IsAws() {
+		profiles, err := ic.workspaceClient.InstanceProfiles.ListAll(ic.Context)
+		if err != nil {
+			return nil, err
+		}
+		for _, profile := range profiles {
+			mc.roles[profile.InstanceProfileArn] = profile.IamRoleArn
+		}
+	}
+	return mc, nil
+}
+
+// planMountsMigration decides which Unity Catalog objects are generated for every mount.
+// Mounts with sources inside of sources of other mounts are mapped to subdirectories of
+// the volume of the outer mount.
+func (ic *importContext) planMountsMigration(mc *mountCredentials) {
+	migrations := map[string]mountMigration{}
+	planned := []mountMigration{}
+	for mountPoint, m := range ic.mountMap {
+		mm := mountMigration{
+			MountPoint: mountPoint,
+			Source:     m.URL,
+		}
+		storageUrl, err := ucStorageUrl(m.URL)
+		if err != nil {
+			mm.Note = err.Error()
+			migrations[mountPoint] = mm
+			continue
+		}
+		mm.Url = storageUrl
+		planned = append(planned, mm)
+	}
+	// outer mounts are processed before inner ones
+	sort.Slice(planned, func(i, j int) bool {
+		if len(planned[i].Url) != len(planned[j].Url) {
+			return len(planned[i].Url) < len(planned[j].Url)
+		}
+		return planned[i].MountPoint < planned[j].MountPoint
+	})
+	owners := []mountMigration{}
+	volumeNames := map[string]struct{}{}
+	for _, mm := range planned {
+		owned := false
+		for _, owner := range owners {
+			if urlCovers(owner.Url, mm.Url) {
+				mm.Owner = owner.MountPoint
+				mm.ExistingLocation = owner.ExistingLocation
+				mm.LocationName = owner.LocationName
+				mm.CredentialName = owner.CredentialName
+				mm.VolumeName = owner.VolumeName
+				mm.VolumePath = owner.VolumePath + strings.TrimPrefix(mm.Url, owner.Url)
+				mm.Note = fmt.Sprintf("source is inside of %s", owner.MountPoint)
+				owned = true
+				break
+			}
+		}
+		if !owned {
+			mm.Owner = mm.MountPoint
+			baseName := strings.ToLower(ic.regexFix(strings.TrimPrefix(mm.MountPoint, "/mnt/"), ic.nameFixes))
+			baseName = strings.Trim(baseName, "_")
+			mm.VolumeName = baseName
+			for i := 2; ; i++ {
+				if _, exists := volumeNames[mm.VolumeName]; !exists {
+					break
+				}
+				mm.VolumeName = fmt.Sprintf("%s_%d", baseName, i)
+			}
+			volumeNames[mm.VolumeName] = struct{}{}
+			mm.VolumePath = fmt.Sprintf("/Volumes/%s/%s/%s", ic.mountsCatalog, ic.mountsSchema, mm.VolumeName)
+			for _, location := range mc.locations {
+				if location.Url == "" {
+					continue
+				}
+				if urlCovers(location.Url, mm.Url) {
+					mm.ExistingLocation = location.Name
+					mm.LocationName = location.Name
+					mm.CredentialName = location.CredentialName
+					break
+				}
+				if urlCovers(mm.Url, location.Url) {
+					mm.Note = fmt.Sprintf("source overlaps with external location %s", location.Name)
+				}
+			}
+			if mm.ExistingLocation == "" {
+				var note string
+				mm.LocationName = "mount_" + mm.VolumeName
+				mm.CredentialName, note = mc.matchStorageCredential(ic.mountMap[mm.MountPoint], mm.Url)
+				if note != "" {
+					mm.Note = note
+				}
+			}
+			owners = append(owners, mm)
+		}
+		migrations[mm.MountPoint] = mm
+	}
+	ic.mountMigrations = migrations
+}
+
+// mountCredentialVariable returns the name of the variable used for storage credential
+// of the mount, for which no existing credential was found
+func (ic *importContext) mountCredentialVariable(mm mountMigration) string {
+	return ic.regexFix("storage_credential_for_"+mm.VolumeName, ic.nameFixes)
+}
+
+// generateMountMigrationBody generates an external location and an external volume
+// instead of the mount
+func generateMountMigrationBody(ic *importContext, body *hclwrite.Body, r *resource) error {
+	mm, ok := ic.mountMigrations[r.ID]
+	if !ok || mm.Url == "" {
+		return fmt.Errorf("mount %s can't be migrated to Unity Catalog", r.ID)
+	}
+	comment := cty.StringVal(fmt.Sprintf("Migrated from mount %s", mm.MountPoint))
+	blockName := "mount_" + r.Name
+	var storageLocation hclwrite.Tokens
+	if mm.ExistingLocation == "" {
+		b := body.AppendNewBlock("resource", []string{"databricks_external_location", blockName}).Body()
+		b.SetAttributeValue("name", cty.StringVal(mm.LocationName))
+		b.SetAttributeValue("url", cty.StringVal(mm.Url))
+		if mm.CredentialName == "" {
+			b.SetAttributeRaw("credential_name", ic.variable(ic.mountCredentialVariable(mm),
+				fmt.Sprintf("Name of storage credential to access %s", mm.Url)))
+		} else if tokens, _ := ic.getTraversalTokens(reference{Resource: "databricks_storage_credential"},
+			mm.CredentialName, r, "credential_name"); tokens != nil {
+			b.SetAttributeRaw("credential_name", tokens)
+		} else {
+			b.SetAttributeValue("credential_name", cty.StringVal(mm.CredentialName))
+		}
+		b.SetAttributeValue("comment", comment)
+		body.AppendNewline()
+		storageLocation = hclwrite.TokensForTraversal(hcl.Traversal{
+			hcl.TraverseRoot{Name: "databricks_external_location"},
+			hcl.TraverseAttr{Name: blockName},
+			hcl.TraverseAttr{Name: "url"},
+		})
+	}
+
+	b := body.AppendNewBlock("resource", []string{"databricks_volume", blockName}).Body()
+	b.SetAttributeValue("name", cty.StringVal(mm.VolumeName))
+	b.SetAttributeValue("catalog_name", cty.StringVal(ic.mountsCatalog))
+	b.SetAttributeValue("schema_name", cty.StringVal(ic.mountsSchema))
+	b.SetAttributeValue("volume_type", cty.StringVal("EXTERNAL"))
+	if storageLocation != nil {
+		b.SetAttributeRaw("storage_location", storageLocation)
+	} else {
+		b.SetAttributeValue("storage_location", cty.StringVal(mm.Url))
+	}
+	b.SetAttributeValue("comment", comment)
+	body.AppendNewline()
+	return nil
+}
+
+// writeMountsMigrationReport writes a mapping of mount points to paths in Unity Catalog volumes
+func (ic *importContext) writeMountsMigrationReport(fileName string) error {
+	mountPoints := make([]string, 0, len(ic.mountMigrations))
+	for mountPoint := range ic.mountMigrations {
+		mountPoints = append(mountPoints, mountPoint)
+	}
+	sort.Strings(mountPoints)
+	f, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := csv.NewWriter(f)
+	err = w.Write([]string{"mount_point", "source", "volume_path", "external_location",
+		"storage_credential", "note"})
+	if err != nil {
+		return err
+	}
+	for _, mountPoint := range mountPoints {
+		mm := ic.mountMigrations[mountPoint]
+		credential := mm.CredentialName
+		if credential == "" && mm.Url != "" {
+			credential = "var." + ic.mountCredentialVariable(mm)
+		}
+		err = w.Write([]string{mm.MountPoint, mm.Source, mm.VolumePath, mm.LocationName,
+			credential, mm.Note})
+		if err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	if err = w.Error(); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Written mapping of %d mounts to volumes into %s", len(mountPoints), fileName)
+	return nil
+}
+
+// listMountsForMigration emits mounts that are generated as Unity Catalog objects, together
+// with the storage credentials and external locations that they use
+func listMountsForMigration(ic *importContext) error {
+	mc, err := ic.loadMountCredentials()
+	if err != nil {
+		return err
+	}
+	ic.planMountsMigration(mc)
+	for mountPoint, mm := range ic.mountMigrations {
+		if !ic.MatchesName(mountPoint) {
+			continue
+		}
+		if mm.Url == "" {
+			log.Printf("[WARN] Mount %s can't be migrated: %s", mountPoint, mm.Note)
+			ic.addIgnoredResource(fmt.Sprintf("databricks_mount. id=%s", mountPoint))
+			continue
+		}
+		if mm.Owner != mountPoint {
+			log.Printf("[INFO] Mount %s is mapped to %s", mountPoint, mm.VolumePath)
+			continue
+		}
+		if mm.ExistingLocation != "" {
+			ic.Emit(&resource{
+				Resource: "databricks_external_location",
+				ID:       mm.ExistingLocation,
+			})
+		} else if mm.CredentialName != "" {
+			ic.Emit(&resource{
+				Resource: "databricks_storage_credential",
+				ID:       mm.CredentialName,
+			})
+		}
+		log.Printf("[INFO] Emitting databricks_mount for migration: %s", mm.Source)
+		ic.Emit(&resource{
+			Resource: "databricks_mount",
+			ID:       mountPoint,
+			Data: ic.Resources["databricks_mount"].Data(
+				&terraform.InstanceState{
+					ID:         mountPoint,
+					Attributes: map[string]string{},
+				}),
+		})
+	}
+	return nil
+}
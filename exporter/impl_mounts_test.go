@@ -0,0 +1,278 @@
+package exporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUcStorageUrl(t *testing.T) {
+	for source, expected := range map[string]string{
+		"s3a://bucket":      "s3://bucket",
+		"s3a://bucket/dir/": "s3://bucket/dir",
+		"gs://bucket/dir":   "gs://bucket/dir",
+		"abfs://cont@acc.dfs.core.windows.net/dir":       "abfss://cont@acc.dfs.core.windows.net/dir",
+		"wasbs://cont@acc.blob.core.windows.net/":        "abfss://cont@acc.dfs.core.windows.net",
+		"abfss://cont@acc.dfs.core.windows.net/dir/sub/": "abfss://cont@acc.dfs.core.windows.net/dir/sub",
+	} {
+		url, err := ucStorageUrl(source)
+		assert.NoError(t, err, source)
+		assert.Equal(t, expected, url, source)
+	}
+	for _, source := range []string{"adl://test.azuredatalakestore.net/dir", "dbfs:/directory", "s3a:///dir"} {
+		_, err := ucStorageUrl(source)
+		assert.EqualError(t, err, source+" is not supported by Unity Catalog")
+	}
+}
+
+func TestMatchStorageCredential(t *testing.T) {
+	mc := &mountCredentials{
+		credentials: []catalog.StorageCredentialInfo{
+			{Name: "aws", AwsIamRole: &catalog.AwsIamRoleResponse{RoleArn: "arn:aws:iam::123:role/mounts"}},
+			{Name: "gcp", DatabricksGcpServiceAccount: &catalog.DatabricksGcpServiceAccountResponse{
+				Email: "sa@project.iam.gserviceaccount.com"}},
+			{Name: "azure", AzureManagedIdentity: &catalog.AzureManagedIdentityResponse{AccessConnectorId: "ac"}},
+		},
+		roles: map[string]string{},
+		instanceProfiles: map[string]string{
+			"aws-cluster": "arn:aws:iam::123:instance-profile/mounts",
+		},
+	}
+	matched := func(m mount, storageUrl string) string {
+		name, note := mc.matchStorageCredential(m, storageUrl)
+		assert.Empty(t, note)
+		return name
+	}
+	assert.Equal(t, "aws", matched(mount{
+		InstanceProfile: "arn:aws:iam::123:instance-profile/mounts"}, "s3://bucket"))
+	assert.Equal(t, "aws", matched(mount{ClusterID: "aws-cluster"}, "s3://bucket"))
+
+	notMatched := func(m mount, storageUrl string) string {
+		name, note := mc.matchStorageCredential(m, storageUrl)
+		assert.Empty(t, name)
+		return note
+	}
+	assert.Equal(t, "no matching storage credential", notMatched(mount{
+		InstanceProfile: "arn:aws:iam::123:instance-profile/other"}, "s3://bucket"))
+	assert.Equal(t, "storage credential must be chosen manually", notMatched(mount{}, "gs://bucket"))
+	assert.Equal(t, "unverified storage credential, check access of: azure",
+		notMatched(mount{}, "abfss://c@a.dfs.core.windows.net"))
+
+	mc.credentials = append(mc.credentials, catalog.StorageCredentialInfo{
+		Name: "aws2", AwsIamRole: &catalog.AwsIamRoleResponse{RoleArn: "arn:aws:iam::123:role/mounts"}})
+	assert.Equal(t, "ambiguous storage credential, one of: aws, aws2", notMatched(mount{
+		InstanceProfile: "arn:aws:iam::123:instance-profile/mounts"}, "s3://bucket"))
+}
+
+func TestMountsMigration(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/storage-credentials?",
+			Response: catalog.ListStorageCredentialsResponse{
+				StorageCredentials: []catalog.StorageCredentialInfo{
+					{
+						Name:       "mounts-role",
+						AwsIamRole: &catalog.AwsIamRoleResponse{RoleArn: "arn:aws:iam::123:role/mounts-role"},
+					},
+					{
+						Name:                 "connector",
+						AzureManagedIdentity: &catalog.AzureManagedIdentityResponse{AccessConnectorId: "ac"},
+					},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/external-locations?",
+			Response: catalog.ListExternalLocationsResponse{
+				ExternalLocations: []catalog.ExternalLocationInfo{
+					{
+						Name:           "landing",
+						Url:            "s3://landing/",
+						CredentialName: "landing-role",
+					},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/clusters/get?cluster_id=mount",
+			Response: compute.ClusterDetails{
+				ClusterId: "mount",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/instance-profiles/list",
+			Response: compute.ListInstanceProfilesResponse{
+				InstanceProfiles: []compute.InstanceProfile{
+					{
+						InstanceProfileArn: "arn:aws:iam::123:instance-profile/mounts",
+						IamRoleArn:         "arn:aws:iam::123:role/mounts-role",
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("mounts,uc-storage-credentials,uc-external-locations")
+		ic.migrateMountsTo = "main.mounts"
+		ic.mountsCatalog = "main"
+		ic.mountsSchema = "mounts"
+		ic.variables = map[string]string{}
+		ic.mountMap = map[string]mount{
+			"/mnt/data": {
+				URL:             "s3a://data",
+				InstanceProfile: "arn:aws:iam::123:instance-profile/mounts",
+			},
+			"/mnt/data-raw": {
+				URL:             "s3a://data/raw/",
+				InstanceProfile: "arn:aws:iam::123:instance-profile/mounts",
+			},
+			"/mnt/landing": {
+				URL:       "s3a://landing/files",
+				ClusterID: "mount",
+			},
+			"/mnt/other": {
+				URL:       "s3a://other",
+				ClusterID: "mount",
+			},
+			"/mnt/legacy": {
+				URL: "adl://legacy.azuredatalakestore.net/dir",
+			},
+			"/mnt/adls": {
+				URL: "abfss://container@account.dfs.core.windows.net/dir",
+			},
+			"/mnt/gcs": {
+				URL:       "gs://bucket",
+				ClusterID: "gcp",
+			},
+		}
+
+		err := listMountsForMigration(ic)
+		require.NoError(t, err)
+		assert.Len(t, ic.testEmits, 7)
+		assert.True(t, ic.testEmits["databricks_mount[<unknown>] (id: /mnt/data)"])
+		assert.True(t, ic.testEmits["databricks_mount[<unknown>] (id: /mnt/landing)"])
+		assert.True(t, ic.testEmits["databricks_mount[<unknown>] (id: /mnt/other)"])
+		assert.True(t, ic.testEmits["databricks_mount[<unknown>] (id: /mnt/adls)"])
+		assert.True(t, ic.testEmits["databricks_mount[<unknown>] (id: /mnt/gcs)"])
+		assert.True(t, ic.testEmits["databricks_storage_credential[<unknown>] (id: mounts-role)"])
+		assert.True(t, ic.testEmits["databricks_external_location[<unknown>] (id: landing)"])
+		assert.Contains(t, ic.ignoredResources, "databricks_mount. id=/mnt/legacy")
+
+		assert.Equal(t, mountMigration{
+			MountPoint:     "/mnt/data-raw",
+			Source:         "s3a://data/raw/",
+			Url:            "s3://data/raw",
+			Owner:          "/mnt/data",
+			LocationName:   "mount_data",
+			CredentialName: "mounts-role",
+			VolumeName:     "data",
+			VolumePath:     "/Volumes/main/mounts/data/raw",
+			Note:           "source is inside of /mnt/data",
+		}, ic.mountMigrations["/mnt/data-raw"])
+
+		f := hclwrite.NewEmptyFile()
+		body := f.Body()
+		for _, name := range []string{"adls", "data", "landing", "other"} {
+			err = generateMountBody(ic, body, &resource{
+				ID:       "/mnt/" + name,
+				Name:     name,
+				Resource: "databricks_mount",
+			})
+			require.NoError(t, err)
+		}
+		err = generateMountBody(ic, body, &resource{
+			ID:       "/mnt/legacy",
+			Name:     "legacy",
+			Resource: "databricks_mount",
+		})
+		assert.EqualError(t, err, "mount /mnt/legacy can't be migrated to Unity Catalog")
+
+		assert.Equal(t, `resource "databricks_external_location" "mount_adls" {
+  name            = "mount_adls"
+  url             = "abfss://container@account.dfs.core.windows.net/dir"
+  credential_name = var.storage_credential_for_adls
+  comment         = "Migrated from mount /mnt/adls"
+}
+
+resource "databricks_volume" "mount_adls" {
+  name             = "adls"
+  catalog_name     = "main"
+  schema_name      = "mounts"
+  volume_type      = "EXTERNAL"
+  storage_location = databricks_external_location.mount_adls.url
+  comment          = "Migrated from mount /mnt/adls"
+}
+
+resource "databricks_external_location" "mount_data" {
+  name            = "mount_data"
+  url             = "s3://data"
+  credential_name = "mounts-role"
+  comment         = "Migrated from mount /mnt/data"
+}
+
+resource "databricks_volume" "mount_data" {
+  name             = "data"
+  catalog_name     = "main"
+  schema_name      = "mounts"
+  volume_type      = "EXTERNAL"
+  storage_location = databricks_external_location.mount_data.url
+  comment          = "Migrated from mount /mnt/data"
+}
+
+resource "databricks_volume" "mount_landing" {
+  name             = "landing"
+  catalog_name     = "main"
+  schema_name      = "mounts"
+  volume_type      = "EXTERNAL"
+  storage_location = "s3://landing/files"
+  comment          = "Migrated from mount /mnt/landing"
+}
+
+resource "databricks_external_location" "mount_other" {
+  name            = "mount_other"
+  url             = "s3://other"
+  credential_name = var.storage_credential_for_other
+  comment         = "Migrated from mount /mnt/other"
+}
+
+resource "databricks_volume" "mount_other" {
+  name             = "other"
+  catalog_name     = "main"
+  schema_name      = "mounts"
+  volume_type      = "EXTERNAL"
+  storage_location = databricks_external_location.mount_other.url
+  comment          = "Migrated from mount /mnt/other"
+}
+
+`, string(hclwrite.Format(f.Bytes())))
+		assert.Contains(t, ic.variables, "storage_credential_for_other")
+
+		reportFileName := filepath.Join(t.TempDir(), "mounts_migration.csv")
+		err = ic.writeMountsMigrationReport(reportFileName)
+		require.NoError(t, err)
+		report, err := os.ReadFile(reportFileName)
+		require.NoError(t, err)
+		assert.Equal(t, `mount_point,source,volume_path,external_location,storage_credential,note
+/mnt/adls,abfss://container@account.dfs.core.windows.net/dir,/Volumes/main/mounts/adls,mount_adls,var.storage_credential_for_adls,"unverified storage credential, check access of: connector"
+/mnt/data,s3a://data,/Volumes/main/mounts/data,mount_data,mounts-role,
+/mnt/data-raw,s3a://data/raw/,/Volumes/main/mounts/data/raw,mount_data,mounts-role,source is inside of /mnt/data
+/mnt/gcs,gs://bucket,/Volumes/main/mounts/gcs,mount_gcs,var.storage_credential_for_gcs,storage credential must be chosen manually
+/mnt/landing,s3a://landing/files,/Volumes/main/mounts/landing,landing,landing-role,
+/mnt/legacy,adl://legacy.azuredatalakestore.net/dir,,,,adl://legacy.azuredatalakestore.net/dir is not supported by Unity Catalog
+/mnt/other,s3a://other,/Volumes/main/mounts/other,mount_other,var.storage_credential_for_other,no matching storage credential
+`, string(report))
+	})
+}
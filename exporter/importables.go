@@ -83,6 +83,9 @@ var (
 )
 
 func generateMountBody(ic *importContext, body *hclwrite.Body, r *resource) error {
+	if ic.mountMigrations != nil {
+		return generateMountMigrationBody(ic, body, r)
+	}
 	mount := ic.mountMap[r.ID]
 
 	b := body.AppendNewBlock("resource", []string{r.Resource, r.Name}).Body()
@@ -253,6 +256,7 @@ var resourcesMap map[string]importable = map[string]importable{
 		Service:        "access",
 		AccountLevel:   true,
 		WorkspaceLevel: true,
+		ForEachGroup:   forEachGroupAll,
 		Depends: []reference{
 			{Path: "group_id", Resource: "databricks_group"},
 			{Path: "role", Resource: "databricks_instance_profile", Match: "instance_profile_arn"},
@@ -262,6 +266,7 @@ var resourcesMap map[string]importable = map[string]importable{
 		Service:        "access",
 		AccountLevel:   true,
 		WorkspaceLevel: true,
+		ForEachGroup:   forEachGroupAll,
 		Depends: []reference{
 			{Path: "user_id", Resource: "databricks_user"},
 			{Path: "role", Resource: "databricks_instance_profile", Match: "instance_profile_arn"},
@@ -271,6 +276,7 @@ var resourcesMap map[string]importable = map[string]importable{
 		Service:        "access",
 		AccountLevel:   true,
 		WorkspaceLevel: true,
+		ForEachGroup:   forEachGroupAll,
 		Depends: []reference{
 			{Path: "service_principal_id", Resource: "databricks_service_principal"},
 			{Path: "role", Resource: "databricks_instance_profile", Match: "instance_profile_arn"},
@@ -506,6 +512,7 @@ var resourcesMap map[string]importable = map[string]importable{
 		Service:        "groups",
 		AccountLevel:   true,
 		WorkspaceLevel: true,
+		ForEachGroup:   forEachGroupAll,
 		Depends: []reference{
 			{Path: "group_id", Resource: "databricks_group"},
 			{Path: "member_id", Resource: "databricks_user"},
@@ -525,9 +532,10 @@ var resourcesMap map[string]importable = map[string]importable{
 			}
 			return nameNormalizationRegex.ReplaceAllString(strings.Split(s, "@")[0], "_") + "_" + d.Id()
 		},
-		List:   listUsers,
-		Search: searchUser,
-		Import: importUser,
+		List:         listUsers,
+		Search:       searchUser,
+		Import:       importUser,
+		ForEachGroup: forEachGroupAll,
 		ShouldOmitField: func(ic *importContext, pathString string, as *schema.Schema, d *schema.ResourceData, r *resource) bool {
 			if r.Mode == "data" {
 				return pathString != "user_name"
@@ -580,6 +588,7 @@ var resourcesMap map[string]importable = map[string]importable{
 	"databricks_permissions": {
 		Service:        "access",
 		WorkspaceLevel: true,
+		ForEachGroup:   forEachGroupAll,
 		Name: func(ic *importContext, d *schema.ResourceData) string {
 			s := strings.Split(d.Id(), "/")
 			return s[len(s)-1]
@@ -706,6 +715,9 @@ var resourcesMap map[string]importable = map[string]importable{
 	"databricks_secret": {
 		WorkspaceLevel: true,
 		Service:        "secrets",
+		ForEachGroup: func(ic *importContext, r *resource) string {
+			return r.Data.Get("scope").(string)
+		},
 		Name: func(ic *importContext, d *schema.ResourceData) string {
 			name := fmt.Sprintf("%s_%s", d.Get("scope"), d.Get("key"))
 			return name + "_" + generateUniqueID(name)
@@ -736,6 +748,9 @@ var resourcesMap map[string]importable = map[string]importable{
 	"databricks_secret_acl": {
 		WorkspaceLevel: true,
 		Service:        "secrets",
+		ForEachGroup: func(ic *importContext, r *resource) string {
+			return r.Data.Get("scope").(string)
+		},
 		Depends: []reference{
 			{Path: "scope", Resource: "databricks_secret_scope"},
 			{Path: "principal", Resource: "databricks_group", Match: "display_name"},
@@ -754,6 +769,9 @@ var resourcesMap map[string]importable = map[string]importable{
 			if err := ic.refreshMounts(); err != nil {
 				return err
 			}
+			if ic.migrateMountsTo != "" {
+				return listMountsForMigration(ic)
+			}
 			for mountName, source := range ic.mountMap {
 				if !ic.MatchesName(mountName) {
 					continue
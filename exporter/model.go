@@ -155,6 +155,9 @@ type importable struct {
 	ShouldOmitField func(ic *importContext, pathString string, as *schema.Schema, d *schema.ResourceData, r *resource) bool
 	// Function to check if the field in the given resource should be generated or not independently of the value
 	ShouldGenerateField func(ic *importContext, pathString string, as *schema.Schema, d *schema.ResourceData, r *resource) bool
+	// Returns name of the `for_each` resource that the given resource is grouped into when it's enabled with `-forEach`.
+	// Nested blocks are generated as `dynamic` blocks, but only one level of nesting is supported
+	ForEachGroup func(ic *importContext, r *resource) string
 	// Defines which API version should be used for this specific resource
 	ApiVersion common.ApiVersion
 	// Defines if specific service is account level resource
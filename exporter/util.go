@@ -406,6 +406,27 @@ func (ic *importContext) enableServices(services string) {
 	}
 }
 
+func (ic *importContext) enableForEach(resourceTypes string) {
+	ic.forEachResources = map[string]struct{}{}
+	for _, rtype := range strings.Split(resourceTypes, ",") {
+		rtype = strings.TrimSpace(rtype)
+		if rtype == "" {
+			continue
+		}
+		ir, exists := ic.Importables[rtype]
+		if !exists || ir.ForEachGroup == nil {
+			log.Printf("[WARN] Resource %s doesn't support generation with for_each", rtype)
+			continue
+		}
+		ic.forEachResources[rtype] = struct{}{}
+	}
+}
+
+// forEachGroupAll puts all resources of the given type into a single `for_each` resource
+func forEachGroupAll(ic *importContext, r *resource) string {
+	return "all"
+}
+
 func (ic *importContext) enableListing(listing string) {
 	ic.listing = map[string]struct{}{}
 	for _, s := range ic.parseServicesList(listing, true) {
@@ -454,39 +475,37 @@ func getEnvAsInt(envName string, defaultValue int) int {
 	return defaultValue
 }
 
-var (
-	maxRetries        = 5
-	retryDelaySeconds = 2
-	retriableErrors   = []string{"deadline exceeded", "Error handling request", "Timed out after ", "Operation timed out"}
-)
+// retryPolicy is used by the exporter to retry listing and reading of objects on top of the retries
+// done by API clients. It's extended with the settings from environment variables in [Run].
+var retryPolicy = common.RetryPolicy{
+	MaxRetries:        4,
+	MinBackoff:        2 * time.Second,
+	RetryableMessages: []string{"deadline exceeded", "Error handling request", "Timed out after ", "Operation timed out"},
+}
 
 func isRetryableError(err string, i int) bool {
-	if i < (maxRetries - 1) {
-		for _, msg := range retriableErrors {
-			if strings.Contains(err, msg) {
-				return true
-			}
-		}
-	}
-	return false
+	return i < retryPolicy.MaxRetries && retryPolicy.IsRetryableMessage(err)
 }
 
 func runWithRetries[ERR any](runFunc func() ERR, msg string) ERR {
 	var err ERR
-	delay := 1
-	for i := 0; i < maxRetries; i++ {
+	for i := 0; i <= retryPolicy.MaxRetries; i++ {
 		err = runFunc()
 		valOf := reflect.ValueOf(&err).Elem()
 		if valOf.IsNil() || valOf.IsZero() {
 			break
 		}
-		if !isRetryableError(fmt.Sprintf("%v", err), i) {
+		retryable := isRetryableError(fmt.Sprintf("%v", err), i)
+		if e, ok := any(err).(error); ok && i < retryPolicy.MaxRetries {
+			retryable = retryable || retryPolicy.IsRetryableError(e)
+		}
+		if !retryable {
 			log.Printf("[ERROR] Error %s after %d retries: %v", msg, i, err)
 			return err
 		}
-		delay = delay * retryDelaySeconds
-		log.Printf("[INFO] next retry (%d) for %s after %d seconds", (i + 1), msg, delay)
-		time.Sleep(time.Duration(delay) * time.Second)
+		delay := retryPolicy.Backoff(i)
+		log.Printf("[INFO] next retry (%d) for %s after %s", (i + 1), msg, delay)
+		time.Sleep(delay)
 	}
 	return err
 }
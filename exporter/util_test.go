@@ -2,9 +2,11 @@ package exporter
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/databricks-sdk-go/service/iam"
@@ -333,6 +335,37 @@ func TestGetEnvAsInt(t *testing.T) {
 	assert.Equal(t, 1, getEnvAsInt("b", 1))
 }
 
+func TestRunWithRetries(t *testing.T) {
+	defer func(p common.RetryPolicy) { retryPolicy = p }(retryPolicy)
+	retryPolicy.MinBackoff = time.Millisecond
+	attempts := 0
+	err := runWithRetries(func() error {
+		attempts++
+		if attempts < 3 {
+			return fmt.Errorf("Operation timed out")
+		}
+		return nil
+	}, "listing")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+
+	attempts = 0
+	err = runWithRetries(func() error {
+		attempts++
+		return fmt.Errorf("deadline exceeded")
+	}, "listing")
+	assert.EqualError(t, err, "deadline exceeded")
+	assert.Equal(t, 5, attempts)
+
+	attempts = 0
+	err = runWithRetries(func() error {
+		attempts++
+		return fmt.Errorf("not found")
+	}, "listing")
+	assert.EqualError(t, err, "not found")
+	assert.Equal(t, 1, attempts)
+}
+
 func TestExcludeAuxiliaryDirectories(t *testing.T) {
 	assert.False(t, isAuxiliaryDirectory(workspace.ObjectStatus{Path: "", ObjectType: workspace.Directory}))
 	assert.False(t, isAuxiliaryDirectory(workspace.ObjectStatus{ObjectType: workspace.File}))
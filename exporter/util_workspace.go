@@ -418,7 +418,7 @@ func recursiveAddPathsParallel(a workspace.NotebooksAPI, directory directoryInfo
 			wg.Add(1)
 			log.Printf("[INFO] attempt %d of retrying listing of '%s' after error: %v",
 				directory.Attempts+1, directory.Path, err)
-			time.Sleep(time.Duration(retryDelaySeconds) * time.Second)
+			time.Sleep(retryPolicy.Backoff(directory.Attempts))
 			dirChannel <- directoryInfo{Path: directory.Path, Attempts: directory.Attempts + 1}
 		}
 	}
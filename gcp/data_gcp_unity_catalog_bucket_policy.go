@@ -0,0 +1,81 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+var gcsBucketNameRegex = regexp.MustCompile(`^[0-9a-z][-_0-9a-z\.]{1,220}[0-9a-z]$`)
+
+// gcpIamPolicy is the format of `google_storage_bucket_iam_policy.policy_data`
+type gcpIamPolicy struct {
+	Version  int                 `json:"version,omitempty"`
+	Bindings []*gcpIamPolicyBind `json:"bindings"`
+}
+
+type gcpIamPolicyBind struct {
+	Role      string                 `json:"role"`
+	Members   []string               `json:"members"`
+	Condition *gcpIamPolicyCondition `json:"condition,omitempty"`
+}
+
+type gcpIamPolicyCondition struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Expression  string `json:"expression"`
+}
+
+// DataGcpUnityCatalogBucketPolicy defines GCS bucket IAM bindings for the service account of a storage credential
+func DataGcpUnityCatalogBucketPolicy() common.Resource {
+	type GcpUcBucketPolicy struct {
+		BucketName          string `json:"bucket_name"`
+		ServiceAccountEmail string `json:"service_account_email"`
+		PathPrefix          string `json:"path_prefix,omitempty"`
+		JSON                string `json:"json" tf:"computed"`
+		Id                  string `json:"id" tf:"computed"`
+	}
+	return common.NoClientData(func(ctx context.Context, data *GcpUcBucketPolicy) error {
+		if !gcsBucketNameRegex.MatchString(data.BucketName) {
+			return fmt.Errorf("bucket_name must contain only lowercase letters, numbers, dashes, underscores and dots")
+		}
+		if !strings.HasSuffix(data.ServiceAccountEmail, ".iam.gserviceaccount.com") {
+			return fmt.Errorf("service_account_email must be an email of a Google service account")
+		}
+		member := fmt.Sprintf("serviceAccount:%s", data.ServiceAccountEmail)
+		policy := gcpIamPolicy{
+			Bindings: []*gcpIamPolicyBind{
+				{
+					Role:    "roles/storage.legacyBucketReader",
+					Members: []string{member},
+				},
+				{
+					Role:    "roles/storage.objectAdmin",
+					Members: []string{member},
+				},
+			},
+		}
+		if data.PathPrefix != "" {
+			// conditions require uniform bucket-level access and version 3 of the policy
+			prefix := strings.Trim(data.PathPrefix, "/")
+			policy.Version = 3
+			policy.Bindings[1].Condition = &gcpIamPolicyCondition{
+				Title:       "databricks-unity-catalog-prefix",
+				Description: fmt.Sprintf("Only objects under %s/", prefix),
+				Expression: fmt.Sprintf(`resource.name.startsWith("projects/_/buckets/%s/objects/%s/")`,
+					data.BucketName, prefix),
+			}
+		}
+		policyJSON, err := json.MarshalIndent(policy, "", "  ")
+		if err != nil {
+			return err
+		}
+		data.JSON = string(policyJSON)
+		data.Id = fmt.Sprintf("%s-%s", data.BucketName, data.ServiceAccountEmail)
+		return nil
+	})
+}
@@ -0,0 +1,88 @@
+package gcp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func compareJSON(t *testing.T, json1 string, json2 string) {
+	var i1 interface{}
+	var i2 interface{}
+	err := json.Unmarshal([]byte(json1), &i1)
+	assert.NoError(t, err, "error while unmarshalling")
+	err = json.Unmarshal([]byte(json2), &i2)
+	assert.NoError(t, err, "error while unmarshalling")
+	assert.Equal(t, i1, i2)
+}
+
+func TestDataGcpUnityCatalogBucketPolicy(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataGcpUnityCatalogBucketPolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL: `
+		bucket_name = "uc-bucket"
+		service_account_email = "db-uc@prod-gcp.iam.gserviceaccount.com"`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	compareJSON(t, d.Get("json").(string), `{
+	  "bindings": [
+	    {
+	      "role": "roles/storage.legacyBucketReader",
+	      "members": ["serviceAccount:db-uc@prod-gcp.iam.gserviceaccount.com"]
+	    },
+	    {
+	      "role": "roles/storage.objectAdmin",
+	      "members": ["serviceAccount:db-uc@prod-gcp.iam.gserviceaccount.com"]
+	    }
+	  ]
+	}`)
+}
+
+func TestDataGcpUnityCatalogBucketPolicy_PathPrefix(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataGcpUnityCatalogBucketPolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL: `
+		bucket_name = "uc-bucket"
+		service_account_email = "db-uc@prod-gcp.iam.gserviceaccount.com"
+		path_prefix = "/sales/"`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	compareJSON(t, d.Get("json").(string), `{
+	  "version": 3,
+	  "bindings": [
+	    {
+	      "role": "roles/storage.legacyBucketReader",
+	      "members": ["serviceAccount:db-uc@prod-gcp.iam.gserviceaccount.com"]
+	    },
+	    {
+	      "role": "roles/storage.objectAdmin",
+	      "members": ["serviceAccount:db-uc@prod-gcp.iam.gserviceaccount.com"],
+	      "condition": {
+	        "title": "databricks-unity-catalog-prefix",
+	        "description": "Only objects under sales/",
+	        "expression": "resource.name.startsWith(\"projects/_/buckets/uc-bucket/objects/sales/\")"
+	      }
+	    }
+	  ]
+	}`)
+}
+
+func TestDataGcpUnityCatalogBucketPolicy_InvalidServiceAccount(t *testing.T) {
+	qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataGcpUnityCatalogBucketPolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL: `
+		bucket_name = "uc-bucket"
+		service_account_email = "someone@example.com"`,
+	}.ExpectError(t, "service_account_email must be an email of a Google service account")
+}
@@ -0,0 +1,87 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// gcpCustomRole is the format of `gcloud iam roles create --file`
+type gcpCustomRole struct {
+	Title               string   `json:"title"`
+	Description         string   `json:"description"`
+	Stage               string   `json:"stage"`
+	IncludedPermissions []string `json:"includedPermissions"`
+}
+
+// DataGcpWorkspaceRole defines permissions of the custom IAM role for the principal that creates workspaces
+func DataGcpWorkspaceRole() common.Resource {
+	type GcpWorkspaceRole struct {
+		PolicyType  string   `json:"policy_type,omitempty" tf:"default:managed"`
+		Cmk         bool     `json:"cmk,omitempty"`
+		Permissions []string `json:"permissions,omitempty" tf:"computed"`
+		JSON        string   `json:"json" tf:"computed"`
+		Id          string   `json:"id" tf:"computed"`
+	}
+	return common.NoClientData(func(ctx context.Context, data *GcpWorkspaceRole) error {
+		if !slices.Contains([]string{"managed", "customer", "restricted"}, data.PolicyType) {
+			return fmt.Errorf("policy_type must be either 'managed', 'customer' or 'restricted'")
+		}
+		permissions := []string{
+			"iam.roles.create",
+			"iam.roles.delete",
+			"iam.roles.get",
+			"iam.roles.update",
+			"iam.serviceAccounts.getIamPolicy",
+			"iam.serviceAccounts.setIamPolicy",
+			"resourcemanager.projects.get",
+			"resourcemanager.projects.getIamPolicy",
+			"resourcemanager.projects.setIamPolicy",
+			"serviceusage.services.get",
+			"serviceusage.services.list",
+			"serviceusage.services.enable",
+		}
+		// customer-managed VPC, that is validated by Databricks
+		if data.PolicyType != "managed" {
+			permissions = append(permissions, []string{
+				"compute.networks.get",
+				"compute.projects.get",
+				"compute.subnetworks.get",
+				"compute.firewalls.get",
+			}...)
+		}
+		// the workspace creator grants access to subnets and creates firewall rules itself, unlike the restricted
+		// mode, where network administrators do it
+		if data.PolicyType == "customer" {
+			permissions = append(permissions, []string{
+				"compute.subnetworks.getIamPolicy",
+				"compute.subnetworks.setIamPolicy",
+				"compute.firewalls.create",
+			}...)
+		}
+		if data.Cmk {
+			permissions = append(permissions, []string{
+				"cloudkms.cryptoKeys.get",
+				"cloudkms.cryptoKeys.getIamPolicy",
+				"cloudkms.cryptoKeys.setIamPolicy",
+			}...)
+		}
+		role := gcpCustomRole{
+			Title:               "Databricks Workspace Creator",
+			Description:         "Permissions to create Databricks workspaces",
+			Stage:               "GA",
+			IncludedPermissions: permissions,
+		}
+		roleJSON, err := json.MarshalIndent(role, "", "  ")
+		if err != nil {
+			return err
+		}
+		data.Permissions = permissions
+		data.JSON = string(roleJSON)
+		data.Id = fmt.Sprintf("%s-%t", data.PolicyType, data.Cmk)
+		return nil
+	})
+}
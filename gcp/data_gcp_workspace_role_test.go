@@ -0,0 +1,65 @@
+package gcp
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataGcpWorkspaceRole_Managed(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataGcpWorkspaceRole(),
+		NonWritable: true,
+		ID:          ".",
+	}.Apply(t)
+	assert.NoError(t, err)
+	permissions := d.Get("permissions").([]any)
+	assert.Len(t, permissions, 12)
+	assert.NotContains(t, permissions, "compute.networks.get")
+	assert.Contains(t, d.Get("json"), `"title": "Databricks Workspace Creator"`)
+}
+
+func TestDataGcpWorkspaceRole_Customer(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataGcpWorkspaceRole(),
+		NonWritable: true,
+		HCL: `
+		policy_type = "customer"
+		cmk = true`,
+		ID: ".",
+	}.Apply(t)
+	assert.NoError(t, err)
+	permissions := d.Get("permissions").([]any)
+	assert.Len(t, permissions, 22)
+	assert.Contains(t, permissions, "compute.subnetworks.setIamPolicy")
+	assert.Contains(t, permissions, "cloudkms.cryptoKeys.setIamPolicy")
+}
+
+func TestDataGcpWorkspaceRole_Restricted(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataGcpWorkspaceRole(),
+		NonWritable: true,
+		HCL:         `policy_type = "restricted"`,
+		ID:          ".",
+	}.Apply(t)
+	assert.NoError(t, err)
+	permissions := d.Get("permissions").([]any)
+	assert.Len(t, permissions, 16)
+	assert.Contains(t, permissions, "compute.subnetworks.get")
+	assert.NotContains(t, permissions, "compute.subnetworks.setIamPolicy")
+	assert.NotContains(t, permissions, "compute.firewalls.create")
+}
+
+func TestDataGcpWorkspaceRole_InvalidPolicyType(t *testing.T) {
+	qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataGcpWorkspaceRole(),
+		NonWritable: true,
+		HCL:         `policy_type = "other"`,
+		ID:          ".",
+	}.ExpectError(t, "policy_type must be either 'managed', 'customer' or 'restricted'")
+}
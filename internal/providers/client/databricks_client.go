@@ -3,6 +3,9 @@ package client
 import (
 	"context"
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 
 	"github.com/databricks/databricks-sdk-go/client"
 	"github.com/databricks/databricks-sdk-go/config"
@@ -17,11 +20,15 @@ import (
 // - ensuring the config is resolved
 // - setting a default retry timeout if not set
 // - setting a default HTTP timeout if not set
+// - configuring client-side retries and rate limiting of the retry policy
+// - writing mutating API calls to the audit log, if configured
+// - tracing HTTP calls, if tracing is set up
 //
 // TODO: this should be colocated with the definition of DatabricksClient in common/client.go, but
 // this isn't possible without introducing a circular dependency. Fixing this will require refactoring
 // DatabricksClient out of the common package.
-func PrepareDatabricksClient(ctx context.Context, cfg *config.Config, configCustomizer func(*config.Config) error) (*common.DatabricksClient, error) {
+func PrepareDatabricksClient(ctx context.Context, cfg *config.Config, retryPolicy common.RetryPolicy,
+	auditLog *common.AuditLog, configCustomizer func(*config.Config) error) (*common.DatabricksClient, error) {
 	if cfg.AuthType != "" {
 		// mapping from previous Google authentication types
 		// and current authentication types from Databricks Go SDK
@@ -53,6 +60,10 @@ func PrepareDatabricksClient(ctx context.Context, cfg *config.Config, configCust
 			return nil, err
 		}
 	}
+	// tracing and the audit log wrap the transport first, so that every retry is recorded
+	common.ConfigureTracing(cfg)
+	auditLog.Configure(cfg)
+	retryPolicy.Configure(cfg)
 	client, err := client.New(cfg)
 	if err != nil {
 		return nil, err
@@ -60,8 +71,155 @@ func PrepareDatabricksClient(ctx context.Context, cfg *config.Config, configCust
 	pc := &common.DatabricksClient{
 		DatabricksClient: client,
 	}
+	pc.SetRetryPolicy(retryPolicy)
 	pc.WithCommandExecutor(func(ctx context.Context, client *common.DatabricksClient) common.CommandExecutor {
 		return commands.NewCommandsAPI(ctx, client)
 	})
 	return pc, nil
 }
+
+const (
+	// SelfIpAttribute is the provider attribute with the public IP address of the machine
+	// running Terraform. It isn't part of the SDK configuration, because it's only used by
+	// the provider to avoid locking itself out of the workspace with IP access lists.
+	SelfIpAttribute = "self_ip"
+
+	// SelfIpEnv is the environment variable used when SelfIpAttribute isn't set.
+	SelfIpEnv = "DATABRICKS_SELF_IP"
+)
+
+// ResolveSelfIP returns the explicitly configured public IP address, falling back to
+// the SelfIpEnv environment variable.
+func ResolveSelfIP(configured string) string {
+	if configured != "" {
+		return configured
+	}
+	return os.Getenv(SelfIpEnv)
+}
+
+// Provider attributes of the retry policy. They aren't part of the SDK configuration, because they
+// configure retries on top of the ones done by the SDK. Environment variables are read by
+// [common.RetryPolicyFromEnv].
+const (
+	MaxRetriesAttribute             = "max_retries"
+	RetryMinBackoffAttribute        = "retry_min_backoff_seconds"
+	RetryMaxBackoffAttribute        = "retry_max_backoff_seconds"
+	HostRateLimitAttribute          = "host_rate_limit"
+	RetryableStatusCodesAttribute   = "retryable_status_codes"
+	RetryableErrorMessagesAttribute = "retryable_error_messages"
+)
+
+// ResolveRetryPolicy returns the retry policy configured in the provider, falling back to
+// environment variables for settings that aren't configured.
+func ResolveRetryPolicy(configured common.RetryPolicy) (common.RetryPolicy, error) {
+	fromEnv, err := common.RetryPolicyFromEnv()
+	if err != nil {
+		return configured, err
+	}
+	policy := configured.WithDefaults(fromEnv)
+	return policy, policy.Validate()
+}
+
+const (
+	// BulkReadCacheAttribute is the provider attribute that enables the bulk read cache, which
+	// serves reads of resources like databricks_secret_acl from one list call per parent.
+	BulkReadCacheAttribute = "bulk_read_cache"
+
+	// BulkReadCacheEnv is the environment variable used when BulkReadCacheAttribute isn't set.
+	BulkReadCacheEnv = "DATABRICKS_BULK_READ_CACHE"
+)
+
+// ResolveBulkReadCache returns true if the bulk read cache is enabled in the provider
+// configuration, falling back to the BulkReadCacheEnv environment variable.
+func ResolveBulkReadCache(configured bool) (bool, error) {
+	if configured {
+		return true, nil
+	}
+	v := os.Getenv(BulkReadCacheEnv)
+	if v == "" {
+		return false, nil
+	}
+	enabled, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", BulkReadCacheEnv, err)
+	}
+	return enabled, nil
+}
+
+const (
+	// AuditLogPathAttribute is the provider attribute with the path of the JSON-lines file,
+	// where every mutating API call is recorded.
+	AuditLogPathAttribute = "audit_log_path"
+
+	// AuditLogRequestBodiesAttribute is the provider attribute to include request bodies
+	// in the audit log, which are redacted by default.
+	AuditLogRequestBodiesAttribute = "audit_log_request_bodies"
+
+	// AuditLogHmacKeyAttribute is the provider attribute with the key used to seal audit log records
+	// with HMAC-SHA256 instead of SHA-256.
+	AuditLogHmacKeyAttribute = "audit_log_hmac_key"
+
+	// AuditLogPathEnv is the environment variable used when AuditLogPathAttribute isn't set.
+	AuditLogPathEnv = "DATABRICKS_AUDIT_LOG_PATH"
+
+	// AuditLogRequestBodiesEnv is the environment variable used when AuditLogRequestBodiesAttribute isn't set.
+	AuditLogRequestBodiesEnv = "DATABRICKS_AUDIT_LOG_REQUEST_BODIES"
+
+	// AuditLogHmacKeyEnv is the environment variable used when AuditLogHmacKeyAttribute isn't set.
+	AuditLogHmacKeyEnv = "DATABRICKS_AUDIT_LOG_HMAC_KEY"
+)
+
+// ResolveAuditLog opens the audit log configured in the provider, falling back to environment
+// variables. It returns nil if the audit log isn't configured.
+func ResolveAuditLog(path string, includeBodies bool, key string) (*common.AuditLog, error) {
+	if path == "" {
+		path = os.Getenv(AuditLogPathEnv)
+	}
+	if path == "" {
+		return nil, nil
+	}
+	if !includeBodies {
+		if v := os.Getenv(AuditLogRequestBodiesEnv); v != "" {
+			var err error
+			includeBodies, err = strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", AuditLogRequestBodiesEnv, err)
+			}
+		}
+	}
+	if key == "" {
+		key = os.Getenv(AuditLogHmacKeyEnv)
+	}
+	return common.OpenAuditLog(path, includeBodies, key)
+}
+
+const (
+	// ProtectedResourceTypesAttribute is the provider attribute with resource types, like
+	// databricks_catalog, that can't be deleted or replaced.
+	ProtectedResourceTypesAttribute = "protected_resource_types"
+
+	// ProtectedResourceTypesEnv is the environment variable with comma-separated resource types,
+	// used when ProtectedResourceTypesAttribute isn't set.
+	ProtectedResourceTypesEnv = "DATABRICKS_PROTECTED_RESOURCE_TYPES"
+)
+
+// ResolveProtectedResourceTypes returns the protected resource types configured in the provider,
+// falling back to the ProtectedResourceTypesEnv environment variable. The databricks_ prefix is
+// added to names without it.
+func ResolveProtectedResourceTypes(configured []string) []string {
+	if len(configured) == 0 {
+		configured = strings.Split(os.Getenv(ProtectedResourceTypesEnv), ",")
+	}
+	resourceTypes := []string{}
+	for _, resourceType := range configured {
+		resourceType = strings.TrimSpace(resourceType)
+		if resourceType == "" {
+			continue
+		}
+		if !strings.HasPrefix(resourceType, "databricks_") {
+			resourceType = "databricks_" + resourceType
+		}
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	return resourceTypes
+}
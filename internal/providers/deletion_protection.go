@@ -0,0 +1,195 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"github.com/databricks/terraform-provider-databricks/internal/providers/client"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// protectedProviderServer fails plans that delete or replace resources of types in protected_resource_types
+// of the provider. It wraps the muxed server, so that it covers resources of both SDKv2 and the plugin framework,
+// and sees replacements required by CustomizeDiff or plan modifiers in RequiresReplace of the plan. The muxed
+// server enables destroy plans, so Terraform 1.3+ asks for a plan before a delete, and the delete fails before
+// anything, including dependent resources, is destroyed. Deletes are also stopped at apply time, for Terraform
+// versions that don't plan destroys.
+type protectedProviderServer struct {
+	tfprotov6.ProviderServer
+	tfprotov6.ActionServer
+	tfprotov6.ListResourceServer
+
+	// protectedTypes are set when the provider is configured
+	protectedTypes atomic.Pointer[[]string]
+}
+
+func newProtectedProviderServer(server tfprotov6.ProviderServer) tfprotov6.ProviderServer {
+	actions, ok := server.(tfprotov6.ProviderServerWithActions)
+	if !ok {
+		return server
+	}
+	lists, ok := server.(tfprotov6.ProviderServerWithListResource)
+	if !ok {
+		return server
+	}
+	return &protectedProviderServer{
+		ProviderServer:     server,
+		ActionServer:       actions,
+		ListResourceServer: lists,
+	}
+}
+
+func (s *protectedProviderServer) ConfigureProvider(ctx context.Context,
+	req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	protectedTypes, diags, err := s.resolveProtectedTypes(ctx, req.Config)
+	if err != nil {
+		return nil, err
+	}
+	if len(diags) > 0 {
+		return &tfprotov6.ConfigureProviderResponse{Diagnostics: diags}, nil
+	}
+	s.protectedTypes.Store(&protectedTypes)
+	return s.ProviderServer.ConfigureProvider(ctx, req)
+}
+
+// resolveProtectedTypes reads protected_resource_types from the provider configuration and checks, that all
+// of them are resources of the provider
+func (s *protectedProviderServer) resolveProtectedTypes(ctx context.Context,
+	config *tfprotov6.DynamicValue) ([]string, []*tfprotov6.Diagnostic, error) {
+	schemas, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, nil, err
+	}
+	configured := []string{}
+	if config != nil && schemas.Provider != nil {
+		value, err := config.Unmarshal(schemas.Provider.ValueType())
+		if err != nil {
+			return nil, nil, err
+		}
+		configured, err = stringsAttribute(value, client.ProtectedResourceTypesAttribute)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	var diags []*tfprotov6.Diagnostic
+	protectedTypes := client.ResolveProtectedResourceTypes(configured)
+	for _, resourceType := range protectedTypes {
+		if _, ok := schemas.ResourceSchemas[resourceType]; !ok {
+			diags = append(diags, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  fmt.Sprintf("Unknown resource type in %s", client.ProtectedResourceTypesAttribute),
+				Detail:   fmt.Sprintf("%s isn't a resource of the provider", resourceType),
+				Attribute: tftypes.NewAttributePath().
+					WithAttributeName(client.ProtectedResourceTypesAttribute),
+			})
+		}
+	}
+	return protectedTypes, diags, nil
+}
+
+// stringsAttribute returns the known elements of the list attribute of the object
+func stringsAttribute(object tftypes.Value, name string) ([]string, error) {
+	var attributes map[string]tftypes.Value
+	if err := object.As(&attributes); err != nil {
+		return nil, err
+	}
+	list, ok := attributes[name]
+	if !ok || !list.IsFullyKnown() || list.IsNull() {
+		return nil, nil
+	}
+	var elements []tftypes.Value
+	if err := list.As(&elements); err != nil {
+		return nil, err
+	}
+	values := []string{}
+	for _, element := range elements {
+		var value string
+		if err := element.As(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+func (s *protectedProviderServer) isProtected(resourceType string) bool {
+	protectedTypes := s.protectedTypes.Load()
+	return protectedTypes != nil && slices.Contains(*protectedTypes, resourceType)
+}
+
+func (s *protectedProviderServer) PlanResourceChange(ctx context.Context,
+	req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if err != nil || resp == nil || !s.isProtected(req.TypeName) || isNullValue(req.PriorState) {
+		return resp, err
+	}
+	if isNullValue(req.ProposedNewState) {
+		resp.Diagnostics = append(resp.Diagnostics, deleteProtectionDiagnostic(req.TypeName))
+		return resp, nil
+	}
+	if len(resp.RequiresReplace) > 0 {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  fmt.Sprintf("%s can't be replaced", req.TypeName),
+			Detail: fmt.Sprintf("%s is in %s of the provider. Replacement is required by changes of: %s. "+
+				"Remove it from the list to allow the replacement.", req.TypeName,
+				client.ProtectedResourceTypesAttribute, attributeNames(resp.RequiresReplace)),
+		})
+	}
+	return resp, nil
+}
+
+func (s *protectedProviderServer) ApplyResourceChange(ctx context.Context,
+	req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	if s.isProtected(req.TypeName) && applyOperation(req) == "delete" {
+		return &tfprotov6.ApplyResourceChangeResponse{
+			NewState:    req.PriorState,
+			Diagnostics: []*tfprotov6.Diagnostic{deleteProtectionDiagnostic(req.TypeName)},
+		}, nil
+	}
+	return s.ProviderServer.ApplyResourceChange(ctx, req)
+}
+
+func deleteProtectionDiagnostic(resourceType string) *tfprotov6.Diagnostic {
+	return &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  fmt.Sprintf("%s can't be deleted", resourceType),
+		Detail: fmt.Sprintf("%s is in %s of the provider. Remove it from the list to allow deletion.",
+			resourceType, client.ProtectedResourceTypesAttribute),
+	}
+}
+
+// attributeNames returns names of the attributes, e.g. options.type for options[0].type
+func attributeNames(paths []*tftypes.AttributePath) string {
+	names := []string{}
+	for _, path := range paths {
+		parts := []string{}
+		for _, step := range path.Steps() {
+			if name, ok := step.(tftypes.AttributeName); ok {
+				parts = append(parts, string(name))
+			}
+		}
+		name := strings.Join(parts, ".")
+		// SDKv2 adds id to attributes of every replacement
+		if name == "id" && len(paths) > 1 {
+			continue
+		}
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return strings.Join(names, ", ")
+}
+
+func isNullValue(value *tfprotov6.DynamicValue) bool {
+	if value == nil {
+		return true
+	}
+	isNull, err := value.IsNull()
+	return err == nil && isNull
+}
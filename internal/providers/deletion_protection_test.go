@@ -0,0 +1,199 @@
+package providers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// objectValue returns the value of the object type with the given attributes, and all other attributes null
+func objectValue(t *testing.T, ty tftypes.Type, values map[string]tftypes.Value) tftypes.Value {
+	object, ok := ty.(tftypes.Object)
+	require.True(t, ok)
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range object.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+		if v, ok := values[name]; ok {
+			attributes[name] = v
+		}
+	}
+	return tftypes.NewValue(ty, attributes)
+}
+
+// stateValue returns the object with the given attributes, or null object if there are no attributes
+func stateValue(t *testing.T, ty tftypes.Type, values map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	if values == nil {
+		return dynamicValue(t, tftypes.NewValue(ty, nil))
+	}
+	return dynamicValue(t, objectValue(t, ty, values))
+}
+
+func stringValue(s string) tftypes.Value {
+	return tftypes.NewValue(tftypes.String, s)
+}
+
+func configureProtectedServer(t *testing.T, protectedTypes ...string) (tfprotov6.ProviderServer,
+	*tfprotov6.GetProviderSchemaResponse, []*tfprotov6.Diagnostic) {
+	ctx := context.Background()
+	server, err := GetProviderServer(ctx)
+	require.NoError(t, err)
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, schemas.Diagnostics)
+	types := []tftypes.Value{}
+	for _, protectedType := range protectedTypes {
+		types = append(types, stringValue(protectedType))
+	}
+	resp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: stateValue(t, schemas.Provider.ValueType(), map[string]tftypes.Value{
+			"host":                     stringValue("https://x"),
+			"token":                    stringValue("x"),
+			"protected_resource_types": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, types),
+		}),
+	})
+	require.NoError(t, err)
+	return server, schemas, resp.Diagnostics
+}
+
+func planChange(t *testing.T, server tfprotov6.ProviderServer, schemas *tfprotov6.GetProviderSchemaResponse,
+	resourceType string, prior, proposed map[string]tftypes.Value) *tfprotov6.PlanResourceChangeResponse {
+	ty := schemas.ResourceSchemas[resourceType].ValueType()
+	proposedState := stateValue(t, ty, proposed)
+	resp, err := server.PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         resourceType,
+		PriorState:       stateValue(t, ty, prior),
+		ProposedNewState: proposedState,
+		Config:           proposedState,
+	})
+	require.NoError(t, err)
+	return resp
+}
+
+func diagnosticSummaries(diags []*tfprotov6.Diagnostic) []string {
+	summaries := []string{}
+	for _, d := range diags {
+		summaries = append(summaries, d.Summary)
+	}
+	return summaries
+}
+
+func TestDeletionProtection_UnknownResourceType(t *testing.T) {
+	_, _, diags := configureProtectedServer(t, "databricks_catalog", "catalgo")
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Unknown resource type in protected_resource_types", diags[0].Summary)
+	assert.Equal(t, "databricks_catalgo isn't a resource of the provider", diags[0].Detail)
+}
+
+func TestDeletionProtection_DestroyIsPlanned(t *testing.T) {
+	_, schemas, diags := configureProtectedServer(t)
+	require.Empty(t, diags)
+	require.NotNil(t, schemas.ServerCapabilities)
+	assert.True(t, schemas.ServerCapabilities.PlanDestroy)
+}
+
+func TestDeletionProtection_FailsDestroyPlan(t *testing.T) {
+	server, schemas, diags := configureProtectedServer(t, "catalog")
+	require.Empty(t, diags)
+	resp := planChange(t, server, schemas, "databricks_catalog", map[string]tftypes.Value{
+		"id":   stringValue("main"),
+		"name": stringValue("main"),
+	}, nil)
+	assert.Equal(t, []string{"databricks_catalog can't be deleted"}, diagnosticSummaries(resp.Diagnostics))
+
+	resp = planChange(t, server, schemas, "databricks_schema", map[string]tftypes.Value{
+		"id":           stringValue("main.default"),
+		"catalog_name": stringValue("main"),
+		"name":         stringValue("default"),
+	}, nil)
+	assert.Empty(t, resp.Diagnostics)
+}
+
+func TestDeletionProtection_FailsDestroyPlanOfPluginFrameworkResource(t *testing.T) {
+	server, schemas, diags := configureProtectedServer(t, "databricks_app")
+	require.Empty(t, diags)
+	resp := planChange(t, server, schemas, "databricks_app", map[string]tftypes.Value{
+		"name": stringValue("dashboard"),
+	}, nil)
+	assert.Equal(t, []string{"databricks_app can't be deleted"}, diagnosticSummaries(resp.Diagnostics))
+}
+
+func TestDeletionProtection_FailsReplacementFromCustomizeDiff(t *testing.T) {
+	server, schemas, diags := configureProtectedServer(t, "databricks_sql_table")
+	require.Empty(t, diags)
+	view := map[string]tftypes.Value{
+		"id":              stringValue("main.default.v"),
+		"catalog_name":    stringValue("main"),
+		"schema_name":     stringValue("default"),
+		"name":            stringValue("v"),
+		"table_type":      stringValue("VIEW"),
+		"view_definition": stringValue("SELECT 1"),
+		"comment":         stringValue("a"),
+		"partitions":      tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+	}
+	changed := map[string]tftypes.Value{}
+	for k, v := range view {
+		changed[k] = v
+	}
+	// comment of a view isn't in the schema as ForceNew, but is forced new with d.ForceNew in CustomizeDiff
+	changed["comment"] = stringValue("b")
+	resp := planChange(t, server, schemas, "databricks_sql_table", view, changed)
+	require.Equal(t, []string{"databricks_sql_table can't be replaced"}, diagnosticSummaries(resp.Diagnostics))
+	assert.Contains(t, resp.Diagnostics[0].Detail, "Replacement is required by changes of: comment.")
+}
+
+func TestDeletionProtection_AllowsUpdates(t *testing.T) {
+	server, schemas, diags := configureProtectedServer(t, "databricks_sql_table")
+	require.Empty(t, diags)
+	table := map[string]tftypes.Value{
+		"id":           stringValue("main.default.t"),
+		"catalog_name": stringValue("main"),
+		"schema_name":  stringValue("default"),
+		"name":         stringValue("t"),
+		"table_type":   stringValue("MANAGED"),
+		"comment":      stringValue("a"),
+		"partitions":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+	}
+	changed := map[string]tftypes.Value{}
+	for k, v := range table {
+		changed[k] = v
+	}
+	changed["comment"] = stringValue("b")
+	resp := planChange(t, server, schemas, "databricks_sql_table", table, changed)
+	assert.Empty(t, resp.Diagnostics)
+	assert.Empty(t, resp.RequiresReplace)
+}
+
+func TestDeletionProtection_FailsDeleteAtApply(t *testing.T) {
+	server, schemas, diags := configureProtectedServer(t, "databricks_catalog")
+	require.Empty(t, diags)
+	ty := schemas.ResourceSchemas["databricks_catalog"].ValueType()
+	prior := stateValue(t, ty, map[string]tftypes.Value{
+		"id":   stringValue("main"),
+		"name": stringValue("main"),
+	})
+	resp, err := server.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     "databricks_catalog",
+		PriorState:   prior,
+		PlannedState: stateValue(t, ty, nil),
+		Config:       stateValue(t, ty, nil),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"databricks_catalog can't be deleted"}, diagnosticSummaries(resp.Diagnostics))
+	assert.Equal(t, prior, resp.NewState)
+}
+
+func TestAttributeNames(t *testing.T) {
+	assert.Equal(t, "name, options.type", attributeNames([]*tftypes.AttributePath{
+		tftypes.NewAttributePath().WithAttributeName("options").WithElementKeyInt(0).WithAttributeName("type"),
+		tftypes.NewAttributePath().WithAttributeName("name"),
+		tftypes.NewAttributePath().WithAttributeName("id"),
+		tftypes.NewAttributePath().WithAttributeName("options").WithElementKeyInt(1).WithAttributeName("type"),
+	}))
+	assert.Equal(t, "id", attributeNames([]*tftypes.AttributePath{
+		tftypes.NewAttributePath().WithAttributeName("id"),
+	}))
+}
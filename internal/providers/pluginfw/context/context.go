@@ -5,6 +5,7 @@ import (
 	"context"
 
 	"github.com/databricks/databricks-sdk-go/useragent"
+	tfcommon "github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/common"
 )
 
@@ -12,10 +13,24 @@ const sdkName = "pluginframework"
 
 func SetUserAgentInResourceContext(ctx context.Context, resourceName string) context.Context {
 	ctx = common.SetSDKInContext(ctx, sdkName)
+	ctx = tfcommon.AuditInContext(ctx, resourceName, "", "")
 	return useragent.InContext(ctx, "resource", resourceName)
 }
 
 func SetUserAgentInDataSourceContext(ctx context.Context, dataSourceName string) context.Context {
 	ctx = common.SetSDKInContext(ctx, sdkName)
+	ctx = tfcommon.AuditInContext(ctx, dataSourceName, "", "read")
 	return useragent.InContext(ctx, "data", dataSourceName)
 }
+
+func SetUserAgentInEphemeralResourceContext(ctx context.Context, ephemeralResourceName string) context.Context {
+	ctx = common.SetSDKInContext(ctx, sdkName)
+	ctx = tfcommon.AuditInContext(ctx, ephemeralResourceName, "", "")
+	return useragent.InContext(ctx, "ephemeral", ephemeralResourceName)
+}
+
+func SetUserAgentInActionContext(ctx context.Context, actionName string) context.Context {
+	ctx = common.SetSDKInContext(ctx, sdkName)
+	ctx = tfcommon.AuditInContext(ctx, actionName, "", "invoke")
+	return useragent.InContext(ctx, "action", actionName)
+}
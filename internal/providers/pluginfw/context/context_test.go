@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go/useragent"
+	tfcommon "github.com/databricks/terraform-provider-databricks/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -14,6 +15,7 @@ func TestSetUserAgentInResourceContext(t *testing.T) {
 	resourceName := "test-resource"
 	actualContext := SetUserAgentInResourceContext(ctx, resourceName)
 	expectedContext := useragent.InContext(ctx, "sdk", "pluginframework")
+	expectedContext = tfcommon.AuditInContext(expectedContext, resourceName, "", "")
 	expectedContext = useragent.InContext(expectedContext, resourceKey, resourceName)
 	assert.Equal(t, expectedContext, actualContext)
 }
@@ -24,6 +26,29 @@ func TestSetUserAgentInDataSourceContext(t *testing.T) {
 	dataSourceName := "test-datasource"
 	actualContext := SetUserAgentInDataSourceContext(ctx, dataSourceName)
 	expectedContext := useragent.InContext(ctx, "sdk", "pluginframework")
+	expectedContext = tfcommon.AuditInContext(expectedContext, dataSourceName, "", "read")
 	expectedContext = useragent.InContext(expectedContext, dataSourceKey, dataSourceName)
 	assert.Equal(t, expectedContext, actualContext)
 }
+
+func TestSetUserAgentInEphemeralResourceContext(t *testing.T) {
+	ctx := context.Background()
+	ephemeralResourceKey := "ephemeral"
+	ephemeralResourceName := "test-ephemeral"
+	actualContext := SetUserAgentInEphemeralResourceContext(ctx, ephemeralResourceName)
+	expectedContext := useragent.InContext(ctx, "sdk", "pluginframework")
+	expectedContext = tfcommon.AuditInContext(expectedContext, ephemeralResourceName, "", "")
+	expectedContext = useragent.InContext(expectedContext, ephemeralResourceKey, ephemeralResourceName)
+	assert.Equal(t, expectedContext, actualContext)
+}
+
+func TestSetUserAgentInActionContext(t *testing.T) {
+	ctx := context.Background()
+	actionKey := "action"
+	actionName := "test-action"
+	actualContext := SetUserAgentInActionContext(ctx, actionName)
+	expectedContext := useragent.InContext(ctx, "sdk", "pluginframework")
+	expectedContext = tfcommon.AuditInContext(expectedContext, actionName, "", "invoke")
+	expectedContext = useragent.InContext(expectedContext, actionKey, actionName)
+	assert.Equal(t, expectedContext, actualContext)
+}
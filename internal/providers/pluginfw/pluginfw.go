@@ -9,13 +9,18 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/databricks/databricks-sdk-go/config"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/client"
 	providercommon "github.com/databricks/terraform-provider-databricks/internal/providers/common"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -45,6 +50,10 @@ type DatabricksProviderPluginFramework struct {
 }
 
 var _ provider.Provider = (*DatabricksProviderPluginFramework)(nil)
+var _ provider.ProviderWithEphemeralResources = (*DatabricksProviderPluginFramework)(nil)
+var _ provider.ProviderWithFunctions = (*DatabricksProviderPluginFramework)(nil)
+var _ provider.ProviderWithActions = (*DatabricksProviderPluginFramework)(nil)
+var _ provider.ProviderWithListResources = (*DatabricksProviderPluginFramework)(nil)
 
 func (p *DatabricksProviderPluginFramework) Resources(ctx context.Context) []func() resource.Resource {
 	return getPluginFrameworkResourcesToRegister(p.sdkV2ResourceFallbacks)
@@ -54,6 +63,22 @@ func (p *DatabricksProviderPluginFramework) DataSources(ctx context.Context) []f
 	return getPluginFrameworkDataSourcesToRegister(p.sdkV2DataSourceFallbacks)
 }
 
+func (p *DatabricksProviderPluginFramework) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return pluginFwOnlyEphemeralResources
+}
+
+func (p *DatabricksProviderPluginFramework) Functions(ctx context.Context) []func() function.Function {
+	return pluginFwOnlyFunctions
+}
+
+func (p *DatabricksProviderPluginFramework) Actions(ctx context.Context) []func() action.Action {
+	return pluginFwOnlyActions
+}
+
+func (p *DatabricksProviderPluginFramework) ListResources(ctx context.Context) []func() list.ListResource {
+	return pluginFwOnlyListResources
+}
+
 func (p *DatabricksProviderPluginFramework) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = providerSchemaPluginFramework()
 }
@@ -67,6 +92,9 @@ func (p *DatabricksProviderPluginFramework) Configure(ctx context.Context, req p
 	client := p.configureDatabricksClient(ctx, req, resp)
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ActionData = client
+	resp.ListResourceData = client
 }
 
 // Function returns a schema.Schema based on config attributes where each attribute is mapped to the appropriate
@@ -92,6 +120,40 @@ func providerSchemaPluginFramework() schema.Schema {
 			}
 		}
 	}
+	ps[client.SelfIpAttribute] = schema.StringAttribute{
+		Optional: true,
+	}
+	ps[client.BulkReadCacheAttribute] = schema.BoolAttribute{
+		Optional: true,
+	}
+	ps[client.AuditLogPathAttribute] = schema.StringAttribute{
+		Optional: true,
+	}
+	ps[client.AuditLogRequestBodiesAttribute] = schema.BoolAttribute{
+		Optional: true,
+	}
+	ps[client.AuditLogHmacKeyAttribute] = schema.StringAttribute{
+		Optional:  true,
+		Sensitive: true,
+	}
+	ps[client.ProtectedResourceTypesAttribute] = schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+	for _, name := range []string{client.MaxRetriesAttribute, client.RetryMinBackoffAttribute,
+		client.RetryMaxBackoffAttribute, client.HostRateLimitAttribute} {
+		ps[name] = schema.Int64Attribute{
+			Optional: true,
+		}
+	}
+	ps[client.RetryableStatusCodesAttribute] = schema.ListAttribute{
+		ElementType: types.Int64Type,
+		Optional:    true,
+	}
+	ps[client.RetryableErrorMessagesAttribute] = schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+	}
 	return schema.Schema{
 		Attributes: ps,
 	}
@@ -169,10 +231,83 @@ func (p *DatabricksProviderPluginFramework) configureDatabricksClient(ctx contex
 	} else {
 		tflog.Info(ctx, "(plugin framework) No attributes specified in provider configuration")
 	}
-	databricksClient, err := client.PrepareDatabricksClient(ctx, cfg, p.configCustomizer)
+	retryPolicy, diags := retryPolicyFromProviderConfig(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+	var auditLogPath types.String
+	var auditLogRequestBodies types.Bool
+	var auditLogHmacKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(client.AuditLogPathAttribute), &auditLogPath)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(client.AuditLogRequestBodiesAttribute), &auditLogRequestBodies)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(client.AuditLogHmacKeyAttribute), &auditLogHmacKey)...)
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+	auditLog, err := client.ResolveAuditLog(auditLogPath.ValueString(), auditLogRequestBodies.ValueBool(),
+		auditLogHmacKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to open audit log", err.Error())
+		return nil
+	}
+	databricksClient, err := client.PrepareDatabricksClient(ctx, cfg, retryPolicy, auditLog, p.configCustomizer)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to configure Databricks client", err.Error())
 		return nil
 	}
+	var selfIp types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(client.SelfIpAttribute), &selfIp)...)
+	databricksClient.SetSelfIP(client.ResolveSelfIP(selfIp.ValueString()))
+	var bulkReadCache types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(client.BulkReadCacheAttribute), &bulkReadCache)...)
+	enabled, err := client.ResolveBulkReadCache(bulkReadCache.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid bulk read cache configuration", err.Error())
+		return nil
+	}
+	if enabled {
+		databricksClient.EnableBulkReadCache()
+	}
 	return databricksClient
 }
+
+// retryPolicyFromProviderConfig reads the retry policy from provider attributes
+func retryPolicyFromProviderConfig(ctx context.Context, providerConfig tfsdk.Config) (common.RetryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	getInt := func(name string) int {
+		var v types.Int64
+		diags.Append(providerConfig.GetAttribute(ctx, path.Root(name), &v)...)
+		return int(v.ValueInt64())
+	}
+	policy := common.RetryPolicy{
+		MaxRetries:    getInt(client.MaxRetriesAttribute),
+		MinBackoff:    time.Duration(getInt(client.RetryMinBackoffAttribute)) * time.Second,
+		MaxBackoff:    time.Duration(getInt(client.RetryMaxBackoffAttribute)) * time.Second,
+		HostRateLimit: getInt(client.HostRateLimitAttribute),
+	}
+	var codes, messages types.List
+	diags.Append(providerConfig.GetAttribute(ctx, path.Root(client.RetryableStatusCodesAttribute), &codes)...)
+	diags.Append(providerConfig.GetAttribute(ctx, path.Root(client.RetryableErrorMessagesAttribute), &messages)...)
+	if diags.HasError() {
+		return policy, diags
+	}
+	var codeValues []int64
+	if !codes.IsNull() && !codes.IsUnknown() {
+		diags.Append(codes.ElementsAs(ctx, &codeValues, false)...)
+	}
+	for _, code := range codeValues {
+		policy.RetryableStatusCodes = append(policy.RetryableStatusCodes, int(code))
+	}
+	if !messages.IsNull() && !messages.IsUnknown() {
+		diags.Append(messages.ElementsAs(ctx, &policy.RetryableMessages, false)...)
+	}
+	if diags.HasError() {
+		return policy, diags
+	}
+	policy, err := client.ResolveRetryPolicy(policy)
+	if err != nil {
+		diags.AddError("Invalid retry policy", err.Error())
+	}
+	return policy, diags
+}
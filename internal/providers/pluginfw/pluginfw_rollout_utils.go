@@ -13,18 +13,27 @@ import (
 	"strings"
 
 	"github.com/databricks/databricks-sdk-go/config"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/actions"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/app"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/catalog"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/cluster"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/dashboards"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/functions"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/library"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/listresources"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/notificationdestinations"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/qualitymonitor"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/registered_model"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/secrets"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/serving"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/sharing"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/tokens"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/volume"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -71,6 +80,52 @@ var pluginFwOnlyDataSources = append(
 	autoGeneratedDataSources...,
 )
 
+// List of ephemeral resources, that are only available in the plugin framework.
+// Keep this list sorted.
+var pluginFwOnlyEphemeralResources = []func() ephemeral.EphemeralResource{
+	secrets.EphemeralSecret,
+	tokens.EphemeralOboToken,
+	tokens.EphemeralServicePrincipalAccessToken,
+	tokens.EphemeralToken,
+}
+
+// List of provider-defined functions, e.g. `provider::databricks::parse_full_name`.
+// Keep this list sorted.
+var pluginFwOnlyFunctions = []func() function.Function{
+	functions.FunctionLocalPath,
+	functions.FunctionParseFullName,
+	functions.FunctionQuoteIdentifier,
+	functions.FunctionSparkVersionCompare,
+	functions.FunctionWorkspaceUrl,
+}
+
+// List of actions, that run imperative operations with `action_trigger` lifecycle blocks or `terraform apply -invoke`.
+// Keep this list sorted.
+var pluginFwOnlyActions = []func() action.Action{
+	actions.ActionClusterRestart,
+	actions.ActionClusterTerminate,
+	actions.ActionJobRunNow,
+	actions.ActionJobStop,
+	actions.ActionPipelineRefresh,
+	actions.ActionRecipientRotateToken,
+	actions.ActionRepoUpdate,
+}
+
+// List of list resources, that enumerate existing resources with `terraform query`. Resources are implemented with SDKv2.
+// Keep this list sorted.
+var pluginFwOnlyListResources = []func() list.ListResource{
+	listresources.ListResourceCatalog,
+	listresources.ListResourceCluster,
+	listresources.ListResourceClusterPolicy,
+	listresources.ListResourceExternalLocation,
+	listresources.ListResourceInstancePool,
+	listresources.ListResourceJob,
+	listresources.ListResourcePipeline,
+	listresources.ListResourceSchema,
+	listresources.ListResourceSqlEndpoint,
+	listresources.ListResourceStorageCredential,
+}
+
 type pluginFrameworkOptions struct {
 	resourceFallbacks   []string
 	dataSourceFallbacks []string
@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -33,6 +36,37 @@ func TestConfigure(t *testing.T) {
 				assert.Equal(t, 30, dc.Config.HTTPTimeoutSeconds, "HTTP timeout should be unset by default")
 			},
 		},
+		{
+			name: "Retry policy can be set in provider config",
+			config: map[string]tftypes.Value{
+				"max_retries":     tftypes.NewValue(tftypes.Number, 3),
+				"host_rate_limit": tftypes.NewValue(tftypes.Number, 5),
+				"retryable_status_codes": tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, []tftypes.Value{
+					tftypes.NewValue(tftypes.Number, 409),
+				}),
+				"retryable_error_messages": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "RESOURCE_CONFLICT"),
+				}),
+			},
+			validateResourceData: func(dc *common.DatabricksClient) {
+				assert.Equal(t, common.RetryPolicy{
+					MaxRetries:           3,
+					HostRateLimit:        5,
+					RetryableStatusCodes: []int{409},
+					RetryableMessages:    []string{"RESOURCE_CONFLICT"},
+				}, dc.RetryPolicy())
+				assert.NotNil(t, dc.Config.HTTPTransport)
+			},
+		},
+		{
+			name: "Bulk read cache can be enabled in provider config",
+			config: map[string]tftypes.Value{
+				"bulk_read_cache": tftypes.NewValue(tftypes.Bool, true),
+			},
+			validateResourceData: func(dc *common.DatabricksClient) {
+				assert.True(t, dc.BulkReadCacheEnabled())
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -63,7 +97,79 @@ func TestConfigure(t *testing.T) {
 			// Get the client from the response
 			client, ok := resp.ResourceData.(*common.DatabricksClient)
 			assert.True(t, ok, "ResourceData should be a DatabricksClient")
+			assert.Equal(t, client, resp.EphemeralResourceData, "EphemeralResourceData should be the same client")
 			tc.validateResourceData(client)
 		})
 	}
 }
+
+func TestEphemeralResources(t *testing.T) {
+	ctx := context.Background()
+	p := GetDatabricksProviderPluginFramework().(*DatabricksProviderPluginFramework)
+	names := []string{}
+	for _, ephemeralResourceFunc := range p.EphemeralResources(ctx) {
+		r := ephemeralResourceFunc()
+		metadata := ephemeral.MetadataResponse{}
+		r.Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: "databricks"}, &metadata)
+		names = append(names, metadata.TypeName)
+		schema := ephemeral.SchemaResponse{}
+		r.Schema(ctx, ephemeral.SchemaRequest{}, &schema)
+		assert.False(t, schema.Diagnostics.HasError(), metadata.TypeName)
+		assert.False(t, schema.Schema.ValidateImplementation(ctx).HasError(), metadata.TypeName)
+	}
+	assert.Equal(t, []string{
+		"databricks_secret",
+		"databricks_obo_token",
+		"databricks_service_principal_access_token",
+		"databricks_token",
+	}, names)
+}
+
+func TestFunctions(t *testing.T) {
+	ctx := context.Background()
+	p := GetDatabricksProviderPluginFramework().(*DatabricksProviderPluginFramework)
+	names := []string{}
+	for _, functionFunc := range p.Functions(ctx) {
+		f := functionFunc()
+		metadata := function.MetadataResponse{}
+		f.Metadata(ctx, function.MetadataRequest{}, &metadata)
+		names = append(names, metadata.Name)
+		definition := function.DefinitionResponse{}
+		f.Definition(ctx, function.DefinitionRequest{}, &definition)
+		validation := function.DefinitionValidateResponse{}
+		definition.Definition.ValidateImplementation(ctx, function.DefinitionValidateRequest{FuncName: metadata.Name}, &validation)
+		assert.False(t, validation.Diagnostics.HasError(), metadata.Name)
+	}
+	assert.Equal(t, []string{
+		"local_path",
+		"parse_full_name",
+		"quote_identifier",
+		"spark_version_compare",
+		"workspace_url",
+	}, names)
+}
+
+func TestActions(t *testing.T) {
+	ctx := context.Background()
+	p := GetDatabricksProviderPluginFramework().(*DatabricksProviderPluginFramework)
+	names := []string{}
+	for _, actionFunc := range p.Actions(ctx) {
+		a := actionFunc()
+		metadata := action.MetadataResponse{}
+		a.Metadata(ctx, action.MetadataRequest{}, &metadata)
+		names = append(names, metadata.TypeName)
+		schema := action.SchemaResponse{}
+		a.Schema(ctx, action.SchemaRequest{}, &schema)
+		assert.False(t, schema.Diagnostics.HasError(), metadata.TypeName)
+		assert.False(t, schema.Schema.ValidateImplementation(ctx).HasError(), metadata.TypeName)
+	}
+	assert.Equal(t, []string{
+		"databricks_cluster_restart",
+		"databricks_cluster_terminate",
+		"databricks_job_run_now",
+		"databricks_job_stop",
+		"databricks_pipeline_refresh",
+		"databricks_recipient_rotate_token",
+		"databricks_repo_update",
+	}, names)
+}
@@ -0,0 +1,85 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/terraform-provider-databricks/clusters"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const clusterRestartName = "cluster_restart"
+
+func ActionClusterRestart() action.Action {
+	return &ClusterRestartAction{}
+}
+
+var _ action.ActionWithConfigure = &ClusterRestartAction{}
+
+// ClusterRestartAction restarts a running cluster, or starts a terminated one, and waits until it's running
+type ClusterRestartAction struct {
+	Client *common.DatabricksClient
+}
+
+type clusterAction struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+}
+
+func (a *ClusterRestartAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(clusterRestartName)
+}
+
+func (a *ClusterRestartAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restarts a running cluster, or starts a terminated one, and waits until it's running",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (a *ClusterRestartAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if a.Client == nil {
+		a.Client = pluginfwcommon.ConfigureAction(req, resp)
+	}
+}
+
+func restartCluster(ctx context.Context, w *databricks.WorkspaceClient, clusterID string, progress progressFunc) error {
+	cluster, err := w.Clusters.GetByClusterId(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+	if cluster.State != compute.StateRunning {
+		progress("Starting cluster %s in state %s", clusterID, cluster.State)
+		_, err = clusters.StartClusterAndGetInfo(ctx, w, clusterID)
+		return err
+	}
+	progress("Restarting cluster %s", clusterID)
+	_, err = w.Clusters.RestartAndWait(ctx, compute.RestartCluster{ClusterId: clusterID})
+	return err
+}
+
+func (a *ClusterRestartAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	ctx = pluginfwcontext.SetUserAgentInActionContext(ctx, clusterRestartName)
+	w, diags := a.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var config clusterAction
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := restartCluster(ctx, w, config.ClusterId.ValueString(), newProgress(resp)); err != nil {
+		resp.Diagnostics.AddError("failed to restart cluster", err.Error())
+	}
+}
@@ -0,0 +1,51 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestartCluster_Running(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	api := w.GetMockClustersAPI()
+	api.EXPECT().GetByClusterId(mock.Anything, "abc").Return(&compute.ClusterDetails{
+		ClusterId: "abc",
+		State:     compute.StateRunning,
+	}, nil)
+	api.EXPECT().RestartAndWait(mock.Anything, compute.RestartCluster{
+		ClusterId: "abc",
+	}).Return(&compute.ClusterDetails{
+		ClusterId: "abc",
+		State:     compute.StateRunning,
+	}, nil)
+	err := restartCluster(context.Background(), w.WorkspaceClient, "abc", noProgress)
+	require.NoError(t, err)
+}
+
+func TestRestartCluster_Terminated(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	api := w.GetMockClustersAPI()
+	api.EXPECT().GetByClusterId(mock.Anything, "abc").Return(&compute.ClusterDetails{
+		ClusterId: "abc",
+		State:     compute.StateTerminated,
+	}, nil).Twice()
+	api.EXPECT().StartByClusterIdAndWait(mock.Anything, "abc").Return(&compute.ClusterDetails{
+		ClusterId: "abc",
+		State:     compute.StateRunning,
+	}, nil)
+	err := restartCluster(context.Background(), w.WorkspaceClient, "abc", noProgress)
+	require.NoError(t, err)
+}
+
+func TestRestartCluster_NotFound(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockClustersAPI().EXPECT().GetByClusterId(mock.Anything, "abc").Return(nil, assert.AnError)
+	err := restartCluster(context.Background(), w.WorkspaceClient, "abc", noProgress)
+	assert.ErrorIs(t, err, assert.AnError)
+}
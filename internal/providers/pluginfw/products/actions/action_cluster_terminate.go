@@ -0,0 +1,79 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+)
+
+const clusterTerminateName = "cluster_terminate"
+
+func ActionClusterTerminate() action.Action {
+	return &ClusterTerminateAction{}
+}
+
+var _ action.ActionWithConfigure = &ClusterTerminateAction{}
+
+// ClusterTerminateAction terminates a cluster and waits until it's terminated. The cluster configuration is kept,
+// so the cluster can be started again.
+type ClusterTerminateAction struct {
+	Client *common.DatabricksClient
+}
+
+func (a *ClusterTerminateAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(clusterTerminateName)
+}
+
+func (a *ClusterTerminateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Terminates a cluster and waits until it's terminated, keeping its configuration",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (a *ClusterTerminateAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if a.Client == nil {
+		a.Client = pluginfwcommon.ConfigureAction(req, resp)
+	}
+}
+
+func terminateCluster(ctx context.Context, w *databricks.WorkspaceClient, clusterID string, progress progressFunc) error {
+	cluster, err := w.Clusters.GetByClusterId(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+	if cluster.State == compute.StateTerminated {
+		progress("Cluster %s is already terminated", clusterID)
+		return nil
+	}
+	progress("Terminating cluster %s", clusterID)
+	_, err = w.Clusters.DeleteByClusterIdAndWait(ctx, clusterID)
+	return err
+}
+
+func (a *ClusterTerminateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	ctx = pluginfwcontext.SetUserAgentInActionContext(ctx, clusterTerminateName)
+	w, diags := a.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var config clusterAction
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := terminateCluster(ctx, w, config.ClusterId.ValueString(), newProgress(resp)); err != nil {
+		resp.Diagnostics.AddError("failed to terminate cluster", err.Error())
+	}
+}
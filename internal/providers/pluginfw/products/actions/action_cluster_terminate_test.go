@@ -0,0 +1,36 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerminateCluster(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	api := w.GetMockClustersAPI()
+	api.EXPECT().GetByClusterId(mock.Anything, "abc").Return(&compute.ClusterDetails{
+		ClusterId: "abc",
+		State:     compute.StateRunning,
+	}, nil)
+	api.EXPECT().DeleteByClusterIdAndWait(mock.Anything, "abc").Return(&compute.ClusterDetails{
+		ClusterId: "abc",
+		State:     compute.StateTerminated,
+	}, nil)
+	err := terminateCluster(context.Background(), w.WorkspaceClient, "abc", noProgress)
+	require.NoError(t, err)
+}
+
+func TestTerminateCluster_AlreadyTerminated(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockClustersAPI().EXPECT().GetByClusterId(mock.Anything, "abc").Return(&compute.ClusterDetails{
+		ClusterId: "abc",
+		State:     compute.StateTerminated,
+	}, nil)
+	err := terminateCluster(context.Background(), w.WorkspaceClient, "abc", noProgress)
+	require.NoError(t, err)
+}
@@ -0,0 +1,128 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const jobRunNowName = "job_run_now"
+
+func ActionJobRunNow() action.Action {
+	return &JobRunNowAction{}
+}
+
+var _ action.ActionWithConfigure = &JobRunNowAction{}
+
+// JobRunNowAction triggers a run of a job and, optionally, waits for it to finish
+type JobRunNowAction struct {
+	Client *common.DatabricksClient
+}
+
+type jobRunNowAction struct {
+	JobId         types.String `tfsdk:"job_id"`
+	JobParameters types.Map    `tfsdk:"job_parameters"`
+	Wait          types.Bool   `tfsdk:"wait"`
+}
+
+func (a *JobRunNowAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(jobRunNowName)
+}
+
+func (a *JobRunNowAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Triggers a run of a job and, optionally, waits for it to finish",
+		Attributes: map[string]schema.Attribute{
+			"job_id": schema.StringAttribute{
+				Required: true,
+			},
+			"job_parameters": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Wait for the run to finish and fail if it wasn't successful. Defaults to `false`.",
+			},
+		},
+	}
+}
+
+func (a *JobRunNowAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if a.Client == nil {
+		a.Client = pluginfwcommon.ConfigureAction(req, resp)
+	}
+}
+
+func parseJobID(jobID string) (int64, error) {
+	id, err := strconv.ParseInt(jobID, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("job_id must be a number: %w", err)
+	}
+	return id, nil
+}
+
+func runJobNow(ctx context.Context, w *databricks.WorkspaceClient, jobID int64, parameters map[string]string,
+	wait bool, progress progressFunc) error {
+	waiter, err := w.Jobs.RunNow(ctx, jobs.RunNow{
+		JobId:         jobID,
+		JobParameters: parameters,
+	})
+	if err != nil {
+		return err
+	}
+	progress("Started run %d of job %d", waiter.RunId, jobID)
+	if !wait {
+		return nil
+	}
+	run, err := waiter.GetWithTimeout(defaultWaitTimeout)
+	if err != nil {
+		return err
+	}
+	if run.State != nil && run.State.ResultState != jobs.RunResultStateSuccess {
+		return fmt.Errorf("run %d of job %d finished with %s: %s", waiter.RunId, jobID,
+			run.State.ResultState, run.State.StateMessage)
+	}
+	progress("Run %d of job %d finished successfully", waiter.RunId, jobID)
+	return nil
+}
+
+func (a *JobRunNowAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	ctx = pluginfwcontext.SetUserAgentInActionContext(ctx, jobRunNowName)
+	w, diags := a.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var config jobRunNowAction
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	jobID, err := parseJobID(config.JobId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("job_id"), "invalid job_id", err.Error())
+		return
+	}
+	var parameters map[string]string
+	if !config.JobParameters.IsNull() {
+		resp.Diagnostics.Append(config.JobParameters.ElementsAs(ctx, &parameters, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	err = runJobNow(ctx, w, jobID, parameters, config.Wait.ValueBool(), newProgress(resp))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to run job", err.Error())
+	}
+}
@@ -0,0 +1,68 @@
+package actions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockRunNow(w *mocks.MockWorkspaceClient, parameters map[string]string, run *jobs.Run) {
+	w.GetMockJobsAPI().EXPECT().RunNow(mock.Anything, jobs.RunNow{
+		JobId:         123,
+		JobParameters: parameters,
+	}).Return(&jobs.WaitGetRunJobTerminatedOrSkipped[jobs.RunNowResponse]{
+		RunId: 456,
+		Poll: func(_ time.Duration, _ func(*jobs.Run)) (*jobs.Run, error) {
+			return run, nil
+		},
+	}, nil)
+}
+
+func TestRunJobNow(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	mockRunNow(w, map[string]string{"env": "prod"}, nil)
+	err := runJobNow(context.Background(), w.WorkspaceClient, 123, map[string]string{"env": "prod"}, false, noProgress)
+	require.NoError(t, err)
+}
+
+func TestRunJobNow_Wait(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	mockRunNow(w, nil, &jobs.Run{
+		RunId: 456,
+		State: &jobs.RunState{
+			LifeCycleState: jobs.RunLifeCycleStateTerminated,
+			ResultState:    jobs.RunResultStateSuccess,
+		},
+	})
+	err := runJobNow(context.Background(), w.WorkspaceClient, 123, nil, true, noProgress)
+	require.NoError(t, err)
+}
+
+func TestRunJobNow_WaitFailed(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	mockRunNow(w, nil, &jobs.Run{
+		RunId: 456,
+		State: &jobs.RunState{
+			LifeCycleState: jobs.RunLifeCycleStateTerminated,
+			ResultState:    jobs.RunResultStateFailed,
+			StateMessage:   "Task failed",
+		},
+	})
+	err := runJobNow(context.Background(), w.WorkspaceClient, 123, nil, true, noProgress)
+	assert.EqualError(t, err, "run 456 of job 123 finished with FAILED: Task failed")
+}
+
+func TestParseJobID(t *testing.T) {
+	id, err := parseJobID("123")
+	require.NoError(t, err)
+	assert.Equal(t, int64(123), id)
+
+	_, err = parseJobID("abc")
+	assert.ErrorContains(t, err, "job_id must be a number")
+}
@@ -0,0 +1,104 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const jobStopName = "job_stop"
+
+func ActionJobStop() action.Action {
+	return &JobStopAction{}
+}
+
+var _ action.ActionWithConfigure = &JobStopAction{}
+
+// JobStopAction cancels all active runs of a job, e.g. to stop a continuous job, and waits until they are terminated
+type JobStopAction struct {
+	Client *common.DatabricksClient
+}
+
+type jobAction struct {
+	JobId types.String `tfsdk:"job_id"`
+}
+
+func (a *JobStopAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(jobStopName)
+}
+
+func (a *JobStopAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Cancels all active runs of a job and waits until they are terminated",
+		Attributes: map[string]schema.Attribute{
+			"job_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (a *JobStopAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if a.Client == nil {
+		a.Client = pluginfwcommon.ConfigureAction(req, resp)
+	}
+}
+
+func stopJob(ctx context.Context, w *databricks.WorkspaceClient, jobID int64, progress progressFunc) error {
+	runs, err := w.Jobs.ListRunsAll(ctx, jobs.ListRunsRequest{
+		JobId:      jobID,
+		ActiveOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+	if len(runs) == 0 {
+		progress("Job %d has no active runs", jobID)
+		return nil
+	}
+	for _, run := range runs {
+		progress("Canceling run %d of job %d", run.RunId, jobID)
+		waiter, err := w.Jobs.CancelRun(ctx, jobs.CancelRun{
+			RunId: run.RunId,
+		})
+		if err != nil {
+			return fmt.Errorf("cannot cancel run %d: %w", run.RunId, err)
+		}
+		_, err = waiter.GetWithTimeout(defaultWaitTimeout)
+		if err != nil {
+			return fmt.Errorf("cannot cancel run, error waiting for run %d to be terminated: %w", run.RunId, err)
+		}
+	}
+	return nil
+}
+
+func (a *JobStopAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	ctx = pluginfwcontext.SetUserAgentInActionContext(ctx, jobStopName)
+	w, diags := a.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var config jobAction
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	jobID, err := parseJobID(config.JobId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("job_id"), "invalid job_id", err.Error())
+		return
+	}
+	if err := stopJob(ctx, w, jobID, newProgress(resp)); err != nil {
+		resp.Diagnostics.AddError("failed to stop job", err.Error())
+	}
+}
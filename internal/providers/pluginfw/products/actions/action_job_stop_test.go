@@ -0,0 +1,56 @@
+package actions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStopJob(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	api := w.GetMockJobsAPI()
+	api.EXPECT().ListRunsAll(mock.Anything, jobs.ListRunsRequest{
+		JobId:      123,
+		ActiveOnly: true,
+	}).Return([]jobs.BaseRun{{RunId: 456}}, nil)
+	api.EXPECT().CancelRun(mock.Anything, jobs.CancelRun{
+		RunId: 456,
+	}).Return(&jobs.WaitGetRunJobTerminatedOrSkipped[struct{}]{
+		RunId: 456,
+		Poll: func(_ time.Duration, _ func(*jobs.Run)) (*jobs.Run, error) {
+			return &jobs.Run{RunId: 456}, nil
+		},
+	}, nil)
+	err := stopJob(context.Background(), w.WorkspaceClient, 123, noProgress)
+	require.NoError(t, err)
+}
+
+func TestStopJob_NoActiveRuns(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockJobsAPI().EXPECT().ListRunsAll(mock.Anything, jobs.ListRunsRequest{
+		JobId:      123,
+		ActiveOnly: true,
+	}).Return([]jobs.BaseRun{}, nil)
+	err := stopJob(context.Background(), w.WorkspaceClient, 123, noProgress)
+	require.NoError(t, err)
+}
+
+func TestStopJob_CancelError(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	api := w.GetMockJobsAPI()
+	api.EXPECT().ListRunsAll(mock.Anything, jobs.ListRunsRequest{
+		JobId:      123,
+		ActiveOnly: true,
+	}).Return([]jobs.BaseRun{{RunId: 456}}, nil)
+	api.EXPECT().CancelRun(mock.Anything, jobs.CancelRun{
+		RunId: 456,
+	}).Return(nil, assert.AnError)
+	err := stopJob(context.Background(), w.WorkspaceClient, 123, noProgress)
+	assert.ErrorContains(t, err, "cannot cancel run 456")
+}
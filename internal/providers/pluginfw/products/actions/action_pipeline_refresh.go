@@ -0,0 +1,107 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	tfpipelines "github.com/databricks/terraform-provider-databricks/pipelines"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const pipelineRefreshName = "pipeline_refresh"
+
+func ActionPipelineRefresh() action.Action {
+	return &PipelineRefreshAction{}
+}
+
+var _ action.ActionWithConfigure = &PipelineRefreshAction{}
+
+// PipelineRefreshAction starts an update of a pipeline and, optionally, waits for it to finish
+type PipelineRefreshAction struct {
+	Client *common.DatabricksClient
+}
+
+type pipelineRefreshAction struct {
+	PipelineId  types.String `tfsdk:"pipeline_id"`
+	FullRefresh types.Bool   `tfsdk:"full_refresh"`
+	Wait        types.Bool   `tfsdk:"wait"`
+}
+
+func (a *PipelineRefreshAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(pipelineRefreshName)
+}
+
+func (a *PipelineRefreshAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an update of a pipeline and, optionally, waits for it to finish",
+		Attributes: map[string]schema.Attribute{
+			"pipeline_id": schema.StringAttribute{
+				Required: true,
+			},
+			"full_refresh": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Reset all tables before running the update. Defaults to `false`.",
+			},
+			"wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Wait for the update to finish and fail if it wasn't completed. Defaults to `false`.",
+			},
+		},
+	}
+}
+
+func (a *PipelineRefreshAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if a.Client == nil {
+		a.Client = pluginfwcommon.ConfigureAction(req, resp)
+	}
+}
+
+func refreshPipeline(ctx context.Context, w *databricks.WorkspaceClient, pipelineID string, fullRefresh, wait bool,
+	progress progressFunc) error {
+	update, err := w.Pipelines.StartUpdate(ctx, pipelines.StartUpdate{
+		PipelineId:  pipelineID,
+		FullRefresh: fullRefresh,
+	})
+	if err != nil {
+		return err
+	}
+	progress("Started update %s of pipeline %s", update.UpdateId, pipelineID)
+	if !wait {
+		return nil
+	}
+	state, err := tfpipelines.WaitForUpdate(w, ctx, pipelineID, update.UpdateId, defaultWaitTimeout)
+	if err != nil {
+		return err
+	}
+	if state != pipelines.UpdateInfoStateCompleted {
+		return fmt.Errorf("update %s of pipeline %s finished with state %s", update.UpdateId, pipelineID, state)
+	}
+	progress("Update %s of pipeline %s completed", update.UpdateId, pipelineID)
+	return nil
+}
+
+func (a *PipelineRefreshAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	ctx = pluginfwcontext.SetUserAgentInActionContext(ctx, pipelineRefreshName)
+	w, diags := a.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var config pipelineRefreshAction
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := refreshPipeline(ctx, w, config.PipelineId.ValueString(), config.FullRefresh.ValueBool(),
+		config.Wait.ValueBool(), newProgress(resp))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to refresh pipeline", err.Error())
+	}
+}
@@ -0,0 +1,54 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockPipelineUpdate(w *mocks.MockWorkspaceClient, state pipelines.UpdateInfoState) {
+	api := w.GetMockPipelinesAPI()
+	api.EXPECT().StartUpdate(mock.Anything, pipelines.StartUpdate{
+		PipelineId:  "abc",
+		FullRefresh: true,
+	}).Return(&pipelines.StartUpdateResponse{
+		UpdateId: "u1",
+	}, nil)
+	if state != "" {
+		api.EXPECT().GetUpdate(mock.Anything, pipelines.GetUpdateRequest{
+			PipelineId: "abc",
+			UpdateId:   "u1",
+		}).Return(&pipelines.GetUpdateResponse{
+			Update: &pipelines.UpdateInfo{
+				UpdateId: "u1",
+				State:    state,
+			},
+		}, nil)
+	}
+}
+
+func TestRefreshPipeline(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	mockPipelineUpdate(w, "")
+	err := refreshPipeline(context.Background(), w.WorkspaceClient, "abc", true, false, noProgress)
+	require.NoError(t, err)
+}
+
+func TestRefreshPipeline_Wait(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	mockPipelineUpdate(w, pipelines.UpdateInfoStateCompleted)
+	err := refreshPipeline(context.Background(), w.WorkspaceClient, "abc", true, true, noProgress)
+	require.NoError(t, err)
+}
+
+func TestRefreshPipeline_WaitFailed(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	mockPipelineUpdate(w, pipelines.UpdateInfoStateFailed)
+	err := refreshPipeline(context.Background(), w.WorkspaceClient, "abc", true, true, noProgress)
+	assert.EqualError(t, err, "update u1 of pipeline abc finished with state FAILED")
+}
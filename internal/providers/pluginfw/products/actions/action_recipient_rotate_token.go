@@ -0,0 +1,94 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const recipientRotateTokenName = "recipient_rotate_token"
+
+func ActionRecipientRotateToken() action.Action {
+	return &RecipientRotateTokenAction{}
+}
+
+var _ action.ActionWithConfigure = &RecipientRotateTokenAction{}
+
+// RecipientRotateTokenAction creates a new activation token for a Delta Sharing recipient with token authentication
+type RecipientRotateTokenAction struct {
+	Client *common.DatabricksClient
+}
+
+type recipientRotateTokenAction struct {
+	Name                         types.String `tfsdk:"name"`
+	ExistingTokenExpireInSeconds types.Int64  `tfsdk:"existing_token_expire_in_seconds"`
+}
+
+func (a *RecipientRotateTokenAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(recipientRotateTokenName)
+}
+
+func (a *RecipientRotateTokenAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates the token of a Delta Sharing recipient that uses token authentication",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"existing_token_expire_in_seconds": schema.Int64Attribute{
+				Required:    true,
+				Description: "Number of seconds after which the existing token expires. Use `0` to expire it immediately.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func (a *RecipientRotateTokenAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if a.Client == nil {
+		a.Client = pluginfwcommon.ConfigureAction(req, resp)
+	}
+}
+
+func rotateRecipientToken(ctx context.Context, w *databricks.WorkspaceClient, name string, expireInSeconds int64,
+	progress progressFunc) error {
+	_, err := w.Recipients.RotateToken(ctx, sharing.RotateRecipientToken{
+		Name:                         name,
+		ExistingTokenExpireInSeconds: expireInSeconds,
+	})
+	if err != nil {
+		return err
+	}
+	progress("Rotated token of recipient %s, the existing token expires in %d seconds", name, expireInSeconds)
+	return nil
+}
+
+func (a *RecipientRotateTokenAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	ctx = pluginfwcontext.SetUserAgentInActionContext(ctx, recipientRotateTokenName)
+	w, diags := a.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var config recipientRotateTokenAction
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := rotateRecipientToken(ctx, w, config.Name.ValueString(), config.ExistingTokenExpireInSeconds.ValueInt64(),
+		newProgress(resp))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to rotate recipient token", err.Error())
+	}
+}
@@ -0,0 +1,33 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateRecipientToken(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockRecipientsAPI().EXPECT().RotateToken(mock.Anything, sharing.RotateRecipientToken{
+		Name:                         "partner",
+		ExistingTokenExpireInSeconds: 3600,
+	}).Return(&sharing.RecipientInfo{
+		Name: "partner",
+	}, nil)
+	err := rotateRecipientToken(context.Background(), w.WorkspaceClient, "partner", 3600, noProgress)
+	require.NoError(t, err)
+}
+
+func TestRotateRecipientToken_Error(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockRecipientsAPI().EXPECT().RotateToken(mock.Anything, sharing.RotateRecipientToken{
+		Name: "partner",
+	}).Return(nil, assert.AnError)
+	err := rotateRecipientToken(context.Background(), w.WorkspaceClient, "partner", 0, noProgress)
+	assert.ErrorIs(t, err, assert.AnError)
+}
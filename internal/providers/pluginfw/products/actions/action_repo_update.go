@@ -0,0 +1,122 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const repoUpdateName = "repo_update"
+
+func ActionRepoUpdate() action.Action {
+	return &RepoUpdateAction{}
+}
+
+var _ action.ActionWithConfigure = &RepoUpdateAction{}
+
+// RepoUpdateAction pulls the latest commit of a branch, or checks out a tag, in a Git folder. Without
+// `branch` and `tag` it syncs the currently checked out branch with its head.
+type RepoUpdateAction struct {
+	Client *common.DatabricksClient
+}
+
+type repoUpdateAction struct {
+	RepoId types.String `tfsdk:"repo_id"`
+	Branch types.String `tfsdk:"branch"`
+	Tag    types.String `tfsdk:"tag"`
+}
+
+func (a *RepoUpdateAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(repoUpdateName)
+}
+
+func (a *RepoUpdateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Syncs a Git folder with the head of a branch, or checks out a tag",
+		Attributes: map[string]schema.Attribute{
+			"repo_id": schema.StringAttribute{
+				Required: true,
+			},
+			"branch": schema.StringAttribute{
+				Optional:    true,
+				Description: "Branch to check out. Defaults to the currently checked out branch.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("tag")),
+				},
+			},
+			"tag": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (a *RepoUpdateAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if a.Client == nil {
+		a.Client = pluginfwcommon.ConfigureAction(req, resp)
+	}
+}
+
+func updateRepo(ctx context.Context, w *databricks.WorkspaceClient, repoID int64, branch, tag string,
+	progress progressFunc) error {
+	if branch == "" && tag == "" {
+		repo, err := w.Repos.GetByRepoId(ctx, repoID)
+		if err != nil {
+			return err
+		}
+		if repo.Branch == "" {
+			return fmt.Errorf("repo %d has no branch checked out, specify `branch` or `tag`", repoID)
+		}
+		branch = repo.Branch
+	}
+	err := w.Repos.Update(ctx, workspace.UpdateRepoRequest{
+		RepoId: repoID,
+		Branch: branch,
+		Tag:    tag,
+	})
+	if err != nil {
+		return err
+	}
+	if tag != "" {
+		progress("Checked out tag %s in repo %d", tag, repoID)
+	} else {
+		progress("Synced repo %d with the head of branch %s", repoID, branch)
+	}
+	return nil
+}
+
+func (a *RepoUpdateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	ctx = pluginfwcontext.SetUserAgentInActionContext(ctx, repoUpdateName)
+	w, diags := a.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var config repoUpdateAction
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	repoID, err := strconv.ParseInt(config.RepoId.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("repo_id"), "invalid repo_id",
+			fmt.Sprintf("repo_id must be a number: %s", err))
+		return
+	}
+	err = updateRepo(ctx, w, repoID, config.Branch.ValueString(), config.Tag.ValueString(), newProgress(resp))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update repo", err.Error())
+	}
+}
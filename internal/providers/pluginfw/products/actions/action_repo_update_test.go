@@ -0,0 +1,47 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateRepo_CurrentBranch(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	api := w.GetMockReposAPI()
+	api.EXPECT().GetByRepoId(mock.Anything, int64(123)).Return(&workspace.GetRepoResponse{
+		Id:     123,
+		Branch: "main",
+	}, nil)
+	api.EXPECT().Update(mock.Anything, workspace.UpdateRepoRequest{
+		RepoId: 123,
+		Branch: "main",
+	}).Return(nil)
+	err := updateRepo(context.Background(), w.WorkspaceClient, 123, "", "", noProgress)
+	require.NoError(t, err)
+}
+
+func TestUpdateRepo_Tag(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockReposAPI().EXPECT().Update(mock.Anything, workspace.UpdateRepoRequest{
+		RepoId: 123,
+		Tag:    "v1.0",
+	}).Return(nil)
+	err := updateRepo(context.Background(), w.WorkspaceClient, 123, "", "v1.0", noProgress)
+	require.NoError(t, err)
+}
+
+func TestUpdateRepo_DetachedHead(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockReposAPI().EXPECT().GetByRepoId(mock.Anything, int64(123)).Return(&workspace.GetRepoResponse{
+		Id:           123,
+		HeadCommitId: "abcdef",
+	}, nil)
+	err := updateRepo(context.Background(), w.WorkspaceClient, 123, "", "", noProgress)
+	assert.EqualError(t, err, "repo 123 has no branch checked out, specify `branch` or `tag`")
+}
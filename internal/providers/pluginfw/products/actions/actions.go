@@ -0,0 +1,27 @@
+// Package actions contains actions, that run imperative operations, like restarting a cluster or running a job,
+// on lifecycle events or with `terraform apply -invoke`.
+package actions
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+)
+
+// defaultWaitTimeout is the maximum amount of time that an action waits for the operation it started to finish
+const defaultWaitTimeout = 60 * time.Minute
+
+// progressFunc reports the progress of a long-running action to the user
+type progressFunc func(format string, args ...any)
+
+func newProgress(resp *action.InvokeResponse) progressFunc {
+	return func(format string, args ...any) {
+		message := fmt.Sprintf(format, args...)
+		log.Printf("[INFO] %s", message)
+		if resp.SendProgress != nil {
+			resp.SendProgress(action.InvokeProgressEvent{Message: message})
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProgress(t *testing.T) {
+	var events []string
+	resp := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			events = append(events, event.Message)
+		},
+	}
+	newProgress(resp)("Started run %d", 123)
+	assert.Equal(t, []string{"Started run 123"}, events)
+}
+
+func TestNewProgress_NoSendProgress(t *testing.T) {
+	assert.NotPanics(t, func() {
+		newProgress(&action.InvokeResponse{})("Started run %d", 123)
+	})
+}
+
+func noProgress(format string, args ...any) {}
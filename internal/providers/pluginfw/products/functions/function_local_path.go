@@ -0,0 +1,45 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/databricks/terraform-provider-databricks/storage"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+func FunctionLocalPath() function.Function {
+	return &LocalPathFunction{}
+}
+
+var _ function.Function = &LocalPathFunction{}
+
+// LocalPathFunction converts `dbfs:` URIs into paths on the local filesystem of a cluster
+type LocalPathFunction struct{}
+
+func (f *LocalPathFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "local_path"
+}
+
+func (f *LocalPathFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts `dbfs:` URIs into local paths",
+		Description: "Converts `dbfs:` URI into the path on the local filesystem of a cluster: `/Volumes/...` " +
+			"for Unity Catalog volumes and `/dbfs/...` for everything else. Other paths are returned as is.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "path",
+				Description: "Path to convert, like `dbfs:/FileStore/init.sh`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *LocalPathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var path string
+	resp.Error = req.Arguments.Get(ctx, &path)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, storage.LocalPath(path))
+}
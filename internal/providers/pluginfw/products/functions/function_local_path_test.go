@@ -0,0 +1,28 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalPath(t *testing.T) {
+	for path, expected := range map[string]string{
+		"dbfs:/FileStore/init.sh":             "/dbfs/FileStore/init.sh",
+		"dbfs:/Volumes/main/default/vol/a.sh": "/Volumes/main/default/vol/a.sh",
+		"/Workspace/Shared/init.sh":           "/Workspace/Shared/init.sh",
+	} {
+		resp := function.RunResponse{
+			Result: function.NewResultData(types.StringUnknown()),
+		}
+		FunctionLocalPath().Run(context.Background(), function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(path)}),
+		}, &resp)
+		assert.Nil(t, resp.Error, path)
+		assert.Equal(t, function.NewResultData(types.StringValue(expected)), resp.Result, path)
+	}
+}
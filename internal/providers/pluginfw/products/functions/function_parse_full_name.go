@@ -0,0 +1,77 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/databricks/terraform-provider-databricks/catalog"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func FunctionParseFullName() function.Function {
+	return &ParseFullNameFunction{}
+}
+
+var _ function.Function = &ParseFullNameFunction{}
+
+// ParseFullNameFunction splits the full name of a Unity Catalog object into catalog, schema and object names
+type ParseFullNameFunction struct{}
+
+type fullName struct {
+	Catalog types.String `tfsdk:"catalog"`
+	Schema  types.String `tfsdk:"schema"`
+	Name    types.String `tfsdk:"name"`
+}
+
+func (f *ParseFullNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_full_name"
+}
+
+func (f *ParseFullNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Splits the full name of a Unity Catalog object",
+		Description: "Splits the full name of a Unity Catalog object, like `catalog.schema.table`, into an object " +
+			"with `catalog`, `schema` and `name` attributes. Attributes, that are missing in the full name, are null. " +
+			"Parts with dots have to be quoted with backticks.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "full_name",
+				Description: "Full name of the Unity Catalog object",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"catalog": types.StringType,
+				"schema":  types.StringType,
+				"name":    types.StringType,
+			},
+		},
+	}
+}
+
+func (f *ParseFullNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = req.Arguments.Get(ctx, &name)
+	if resp.Error != nil {
+		return
+	}
+	parts, err := catalog.SplitFullName(name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if len(parts) > 3 {
+		resp.Error = function.NewArgumentFuncError(0, "full name must have at most three parts: catalog.schema.name")
+		return
+	}
+	result := fullName{
+		Catalog: types.StringNull(),
+		Schema:  types.StringNull(),
+		Name:    types.StringNull(),
+	}
+	for i, v := range []*types.String{&result.Catalog, &result.Schema, &result.Name}[:len(parts)] {
+		*v = types.StringValue(parts[i])
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
@@ -0,0 +1,60 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func runParseFullName(name string) function.RunResponse {
+	f := FunctionParseFullName()
+	definition := function.DefinitionResponse{}
+	f.Definition(context.Background(), function.DefinitionRequest{}, &definition)
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.ObjectUnknown(definition.Definition.Return.GetType().(types.ObjectType).AttrTypes)),
+	}
+	f.Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(name)}),
+	}, &resp)
+	return resp
+}
+
+func fullNameValue(catalog, schema, name types.String) attr.Value {
+	return types.ObjectValueMust(map[string]attr.Type{
+		"catalog": types.StringType,
+		"schema":  types.StringType,
+		"name":    types.StringType,
+	}, map[string]attr.Value{
+		"catalog": catalog,
+		"schema":  schema,
+		"name":    name,
+	})
+}
+
+func TestParseFullName(t *testing.T) {
+	resp := runParseFullName("main.default.`my.table`")
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, function.NewResultData(fullNameValue(
+		types.StringValue("main"), types.StringValue("default"), types.StringValue("my.table"))), resp.Result)
+}
+
+func TestParseFullName_Schema(t *testing.T) {
+	resp := runParseFullName("main.default")
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, function.NewResultData(fullNameValue(
+		types.StringValue("main"), types.StringValue("default"), types.StringNull())), resp.Result)
+}
+
+func TestParseFullName_TooManyParts(t *testing.T) {
+	resp := runParseFullName("a.b.c.d")
+	assert.Equal(t, function.NewArgumentFuncError(0, "full name must have at most three parts: catalog.schema.name"), resp.Error)
+}
+
+func TestParseFullName_Invalid(t *testing.T) {
+	resp := runParseFullName("main..table")
+	assert.Equal(t, function.NewArgumentFuncError(0, `invalid full name "main..table": empty part`), resp.Error)
+}
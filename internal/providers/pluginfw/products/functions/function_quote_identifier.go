@@ -0,0 +1,52 @@
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/catalog"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+func FunctionQuoteIdentifier() function.Function {
+	return &QuoteIdentifierFunction{}
+}
+
+var _ function.Function = &QuoteIdentifierFunction{}
+
+// QuoteIdentifierFunction quotes identifiers with backticks, so they could be used in SQL statements
+type QuoteIdentifierFunction struct{}
+
+func (f *QuoteIdentifierFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "quote_identifier"
+}
+
+func (f *QuoteIdentifierFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Quotes identifiers with backticks",
+		Description: "Quotes each of the given identifiers with backticks, doubling backticks inside them, " +
+			"and joins them with dots, so the result could be used as a name in SQL statements.",
+		VariadicParameter: function.StringParameter{
+			Name:        "names",
+			Description: "Identifiers to quote, like catalog, schema and table names",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *QuoteIdentifierFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var names []string
+	resp.Error = req.Arguments.Get(ctx, &names)
+	if resp.Error != nil {
+		return
+	}
+	if len(names) == 0 {
+		resp.Error = function.NewArgumentFuncError(0, "at least one identifier is required")
+		return
+	}
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, catalog.QuoteIdentifier(name))
+	}
+	resp.Error = resp.Result.Set(ctx, strings.Join(quoted, "."))
+}
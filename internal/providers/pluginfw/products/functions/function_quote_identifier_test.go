@@ -0,0 +1,38 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func runQuoteIdentifier(names ...string) function.RunResponse {
+	elemTypes := []attr.Type{}
+	values := []attr.Value{}
+	for _, name := range names {
+		elemTypes = append(elemTypes, types.StringType)
+		values = append(values, types.StringValue(name))
+	}
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+	FunctionQuoteIdentifier().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.TupleValueMust(elemTypes, values)}),
+	}, &resp)
+	return resp
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	resp := runQuoteIdentifier("main", "my-schema", "weird`table")
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, function.NewResultData(types.StringValue("`main`.`my-schema`.`weird``table`")), resp.Result)
+}
+
+func TestQuoteIdentifier_Empty(t *testing.T) {
+	resp := runQuoteIdentifier()
+	assert.Equal(t, function.NewArgumentFuncError(0, "at least one identifier is required"), resp.Error)
+}
@@ -0,0 +1,55 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/databricks/terraform-provider-databricks/clusters"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+func FunctionSparkVersionCompare() function.Function {
+	return &SparkVersionCompareFunction{}
+}
+
+var _ function.Function = &SparkVersionCompareFunction{}
+
+// SparkVersionCompareFunction compares Databricks Runtime versions
+type SparkVersionCompareFunction struct{}
+
+func (f *SparkVersionCompareFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "spark_version_compare"
+}
+
+func (f *SparkVersionCompareFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compares Databricks Runtime versions",
+		Description: "Compares major and minor Databricks Runtime versions of Spark version keys, like `15.4.x-scala2.12`, " +
+			"ignoring the variant, like `ml` or `photon`. Returns -1 if the first version is lower, 0 if they are " +
+			"equal and 1 if the first version is higher.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "a",
+				Description: "First Spark version, like `15.4.x-scala2.12` or `15.4`",
+			},
+			function.StringParameter{
+				Name:        "b",
+				Description: "Second Spark version, like `16.2.x-photon-scala2.12` or `16.2`",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *SparkVersionCompareFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+	resp.Error = req.Arguments.Get(ctx, &a, &b)
+	if resp.Error != nil {
+		return
+	}
+	result, err := clusters.CompareSparkVersions(a, b)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, int64(result))
+}
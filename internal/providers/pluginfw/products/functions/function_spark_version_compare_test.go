@@ -0,0 +1,32 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func runSparkVersionCompare(a, b string) function.RunResponse {
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.Int64Unknown()),
+	}
+	FunctionSparkVersionCompare().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(a), types.StringValue(b)}),
+	}, &resp)
+	return resp
+}
+
+func TestSparkVersionCompare(t *testing.T) {
+	resp := runSparkVersionCompare("15.4.x-scala2.12", "16.2.x-photon-scala2.12")
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, function.NewResultData(types.Int64Value(-1)), resp.Result)
+}
+
+func TestSparkVersionCompare_Invalid(t *testing.T) {
+	resp := runSparkVersionCompare("latest", "15.4")
+	assert.Equal(t, function.NewFuncError(`invalid Spark version "latest": expected format like 15.4.x-scala2.12`), resp.Error)
+}
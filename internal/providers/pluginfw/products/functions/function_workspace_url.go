@@ -0,0 +1,53 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/databricks/terraform-provider-databricks/mws"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+func FunctionWorkspaceUrl() function.Function {
+	return &WorkspaceUrlFunction{}
+}
+
+var _ function.Function = &WorkspaceUrlFunction{}
+
+// WorkspaceUrlFunction builds the URL of a workspace from its deployment name
+type WorkspaceUrlFunction struct{}
+
+func (f *WorkspaceUrlFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "workspace_url"
+}
+
+func (f *WorkspaceUrlFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds the URL of a workspace from its deployment name",
+		Description: "Builds the URL of a workspace from its deployment name, by replacing `accounts` in the host " +
+			"of the account console with the deployment name, the same way as `databricks_mws_workspaces` does.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "deployment_name",
+				Description: "Deployment name of the workspace",
+			},
+			function.StringParameter{
+				Name:        "account_host",
+				Description: "Host of the account console, like `https://accounts.cloud.databricks.com`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *WorkspaceUrlFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var deploymentName, accountHost string
+	resp.Error = req.Arguments.Get(ctx, &deploymentName, &accountHost)
+	if resp.Error != nil {
+		return
+	}
+	if deploymentName == "" {
+		resp.Error = function.NewArgumentFuncError(0, "deployment name must not be empty")
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, "https://"+mws.WorkspaceHostname(accountHost, deploymentName))
+}
@@ -0,0 +1,38 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func runWorkspaceUrl(deploymentName, accountHost string) function.RunResponse {
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+	FunctionWorkspaceUrl().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(deploymentName), types.StringValue(accountHost)}),
+	}, &resp)
+	return resp
+}
+
+func TestWorkspaceUrl(t *testing.T) {
+	resp := runWorkspaceUrl("my-workspace", "https://accounts.gcp.databricks.com")
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, function.NewResultData(types.StringValue("https://my-workspace.gcp.databricks.com")), resp.Result)
+}
+
+func TestWorkspaceUrl_HostWithoutScheme(t *testing.T) {
+	resp := runWorkspaceUrl("my-workspace", "accounts.cloud.databricks.com")
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, function.NewResultData(types.StringValue("https://my-workspace.cloud.databricks.com")), resp.Result)
+}
+
+func TestWorkspaceUrl_EmptyDeploymentName(t *testing.T) {
+	resp := runWorkspaceUrl("", "https://accounts.cloud.databricks.com")
+	assert.Equal(t, function.NewArgumentFuncError(0, "deployment name must not be empty"), resp.Error)
+}
@@ -0,0 +1,199 @@
+// Package listresources contains list resources, that enumerate existing resources with `terraform query`
+// in Terraform 1.14+, so that they can be imported without looking up their IDs first.
+package listresources
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/listing"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// listedResource is an existing resource, that is returned by the list API
+type listedResource struct {
+	// ID of the resource, the same as used to import it
+	id string
+	// human-readable name, that `terraform query` shows next to the identity
+	displayName string
+}
+
+// listFunc lists resources with the Go SDK, using the configuration of the `list` block
+type listFunc func(ctx context.Context, w *databricks.WorkspaceClient, config tfsdk.Config) (iter.Seq2[listedResource, error], diag.Diagnostics)
+
+// sdkV2ListResource lists resources, that are implemented with SDKv2. Identities of listed resources are derived from
+// their IDs, and the resources themselves are read with the Read of the SDKv2 resource, when Terraform asks for them.
+type sdkV2ListResource struct {
+	// name of the resource without the `databricks_` prefix
+	name string
+	// SDKv2 resource, that must have an identity
+	resource common.Resource
+	// attributes of the `list` block in `.tfquery.hcl` files
+	attributes map[string]schema.Attribute
+	list       listFunc
+
+	Client *common.DatabricksClient
+}
+
+var _ list.ListResourceWithConfigure = &sdkV2ListResource{}
+var _ list.ListResourceWithRawV6Schemas = &sdkV2ListResource{}
+
+func (r *sdkV2ListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(r.name)
+}
+
+func (r *sdkV2ListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Lists existing %s resources", pluginfwcommon.GetDatabricksProductionName(r.name)),
+		Attributes:  r.attributes,
+	}
+}
+
+// RawV6Schemas returns schemas of the SDKv2 resource, because the resource isn't defined in the plugin framework
+func (r *sdkV2ListResource) RawV6Schemas(ctx context.Context, req list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	sr := r.resource.ToResource()
+	resp.ProtoV6Schema = schemaToV6(sr.ProtoSchema(ctx)())
+	resp.ProtoV6IdentitySchema = identitySchemaToV6(sr.ProtoIdentitySchema(ctx)())
+}
+
+func (r *sdkV2ListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if r.Client == nil {
+		r.Client = pluginfwcommon.ConfigureResource(req, resp)
+	}
+}
+
+func (r *sdkV2ListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	ctx = pluginfwcontext.SetUserAgentInResourceContext(ctx, r.name)
+	w, diags := r.Client.GetWorkspaceClient()
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	items, diags := r.list(ctx, w, req.Config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for item, err := range items {
+			if err != nil {
+				var diags diag.Diagnostics
+				diags.AddError(fmt.Sprintf("failed to list %s resources", r.name), err.Error())
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			result, found := r.listResult(ctx, req, item)
+			if !found {
+				// the resource was deleted after it was listed
+				continue
+			}
+			if !push(result) {
+				return
+			}
+			count++
+		}
+	}
+}
+
+func (r *sdkV2ListResource) listResult(ctx context.Context, req list.ListRequest, item listedResource) (list.ListResult, bool) {
+	result := req.NewListResult(ctx)
+	result.DisplayName = item.displayName
+	parts, ok := r.resource.Identity.Split(item.id)
+	if !ok {
+		result.Diagnostics.AddError(fmt.Sprintf("failed to set identity of %s", r.name),
+			fmt.Sprintf("unexpected format of ID: %s", item.id))
+		return result, true
+	}
+	for i, attr := range r.resource.Identity.Attributes {
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(attr), parts[i])...)
+	}
+	if !req.IncludeResource {
+		return result, true
+	}
+	found, diags := r.read(ctx, item.id, result.Resource)
+	result.Diagnostics.Append(diags...)
+	return result, found || diags.HasError()
+}
+
+// read sets the state of the resource with the given ID, as it would be after the import
+func (r *sdkV2ListResource) read(ctx context.Context, id string, target *tfsdk.Resource) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	sr := r.resource.ToResource()
+	common.AddContextToResource(r.name, sr)
+	d := sr.Data(&terraform.InstanceState{ID: id})
+	// like the importer does, so that attributes, that aren't computed, are kept in the state
+	d.MarkNewResource()
+	diags.Append(fromSdkV2Diagnostics(sr.ReadContext(ctx, d, r.Client))...)
+	if diags.HasError() || d.Id() == "" {
+		return false, diags
+	}
+	ty := sr.CoreConfigSchema().ImpliedType()
+	value, err := d.State().AttrsAsObjectValue(ty)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed to convert state of %s", r.name), err.Error())
+		return false, diags
+	}
+	encoded, err := msgpack.Marshal(value, ty)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed to encode state of %s", r.name), err.Error())
+		return false, diags
+	}
+	raw, err := (&tfprotov6.DynamicValue{MsgPack: encoded}).Unmarshal(target.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed to decode state of %s", r.name), err.Error())
+		return false, diags
+	}
+	target.Raw = raw
+	return true, diags
+}
+
+// fromSdkV2Diagnostics converts diagnostics of SDKv2 operations to the plugin framework ones
+func fromSdkV2Diagnostics(sdkDiags sdkdiag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, d := range sdkDiags {
+		if d.Severity == sdkdiag.Error {
+			diags.AddError(d.Summary, d.Detail)
+		} else {
+			diags.AddWarning(d.Summary, d.Detail)
+		}
+	}
+	return diags
+}
+
+// listed adapts the iterator of the Go SDK, skipping items, for which convert returns false
+func listed[T any](ctx context.Context, it listing.Iterator[T], convert func(T) (listedResource, bool)) iter.Seq2[listedResource, error] {
+	return func(yield func(listedResource, error) bool) {
+		for it.HasNext(ctx) {
+			item, err := it.Next(ctx)
+			if err != nil {
+				yield(listedResource{}, err)
+				return
+			}
+			lr, ok := convert(item)
+			if !ok {
+				continue
+			}
+			if !yield(lr, nil) {
+				return
+			}
+		}
+	}
+}
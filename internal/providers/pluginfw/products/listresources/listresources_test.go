@@ -0,0 +1,178 @@
+package listresources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/listing"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// testProvider serves a single list resource with the preconfigured client
+type testProvider struct {
+	client       *common.DatabricksClient
+	listResource func() list.ListResource
+}
+
+func (p *testProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "databricks"
+}
+
+func (p *testProvider) Schema(context.Context, provider.SchemaRequest, *provider.SchemaResponse) {}
+
+func (p *testProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	resp.ListResourceData = p.client
+}
+
+func (p *testProvider) Resources(context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *testProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *testProvider) ListResources(context.Context) []func() list.ListResource {
+	return []func() list.ListResource{p.listResource}
+}
+
+type listedResult struct {
+	displayName string
+	identity    map[string]tftypes.Value
+	resource    map[string]tftypes.Value
+}
+
+// listThroughProvider lists resources the same way as `terraform query` does
+func listThroughProvider(t *testing.T, client *common.DatabricksClient, listResource func() list.ListResource,
+	typeName string, config map[string]tftypes.Value, includeResource bool, limit int64) []listedResult {
+	ctx := context.Background()
+	server := providerserver.NewProtocol6(&testProvider{client: client, listResource: listResource})()
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, schemas.Diagnostics)
+	providerConfig, err := tfprotov6.NewDynamicValue(schemas.Provider.ValueType(),
+		tftypes.NewValue(schemas.Provider.ValueType(), map[string]tftypes.Value{}))
+	require.NoError(t, err)
+	configured, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &providerConfig})
+	require.NoError(t, err)
+	require.Empty(t, configured.Diagnostics)
+
+	configType := schemas.ListResourceSchemas[typeName].ValueType()
+	listConfig, err := tfprotov6.NewDynamicValue(configType, tftypes.NewValue(configType, config))
+	require.NoError(t, err)
+	stream, err := server.(tfprotov6.ProviderServerWithListResource).ListResource(ctx, &tfprotov6.ListResourceRequest{
+		TypeName:        typeName,
+		Config:          &listConfig,
+		IncludeResource: includeResource,
+		Limit:           limit,
+	})
+	require.NoError(t, err)
+
+	// the resource itself is served by SDKv2, so its schemas only come from the list resource
+	raw := list.RawV6SchemaResponse{}
+	listResource().(list.ListResourceWithRawV6Schemas).RawV6Schemas(ctx, list.RawV6SchemaRequest{}, &raw)
+	results := []listedResult{}
+	for result := range stream.Results {
+		require.Empty(t, result.Diagnostics)
+		lr := listedResult{displayName: result.DisplayName}
+		identity, err := result.Identity.IdentityData.Unmarshal(raw.ProtoV6IdentitySchema.ValueType())
+		require.NoError(t, err)
+		require.NoError(t, identity.As(&lr.identity))
+		if result.Resource != nil {
+			value, err := result.Resource.Unmarshal(raw.ProtoV6Schema.ValueType())
+			require.NoError(t, err)
+			require.NoError(t, value.As(&lr.resource))
+		}
+		results = append(results, lr)
+	}
+	return results
+}
+
+func TestListCatalogs(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		e := w.GetMockCatalogsAPI().EXPECT()
+		e.List(mock.Anything, catalog.ListCatalogsRequest{}).Return(&listing.SliceIterator[catalog.CatalogInfo]{
+			{Name: "main", CatalogType: catalog.CatalogTypeManagedCatalog},
+			{Name: "system", CatalogType: catalog.CatalogTypeSystemCatalog},
+			{Name: "sandbox", CatalogType: catalog.CatalogTypeManagedCatalog},
+		})
+		e.GetByName(mock.Anything, "main").Return(&catalog.CatalogInfo{Name: "main", Comment: "production"}, nil)
+		e.GetByName(mock.Anything, "sandbox").Return(&catalog.CatalogInfo{Name: "sandbox", Comment: "experiments"}, nil)
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		results := listThroughProvider(t, client, ListResourceCatalog, "databricks_catalog", nil, true, 0)
+		require.Len(t, results, 2)
+		assert.Equal(t, "main", results[0].displayName)
+		assert.Equal(t, tftypes.NewValue(tftypes.String, "main"), results[0].identity["name"])
+		assert.Equal(t, tftypes.NewValue(tftypes.String, "main"), results[0].resource["id"])
+		assert.Equal(t, tftypes.NewValue(tftypes.String, "production"), results[0].resource["comment"])
+		assert.Equal(t, tftypes.NewValue(tftypes.String, "sandbox"), results[1].identity["name"])
+		assert.Equal(t, tftypes.NewValue(tftypes.String, "experiments"), results[1].resource["comment"])
+	})
+}
+
+func TestListCatalogs_DeletedWhileListing(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		e := w.GetMockCatalogsAPI().EXPECT()
+		e.List(mock.Anything, catalog.ListCatalogsRequest{}).Return(&listing.SliceIterator[catalog.CatalogInfo]{
+			{Name: "main"},
+			{Name: "sandbox"},
+		})
+		e.GetByName(mock.Anything, "main").Return(nil, apierr.ErrResourceDoesNotExist)
+		e.GetByName(mock.Anything, "sandbox").Return(&catalog.CatalogInfo{Name: "sandbox"}, nil)
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		results := listThroughProvider(t, client, ListResourceCatalog, "databricks_catalog", nil, true, 0)
+		require.Len(t, results, 1)
+		assert.Equal(t, tftypes.NewValue(tftypes.String, "sandbox"), results[0].identity["name"])
+	})
+}
+
+func TestListClusters(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		w.GetMockClustersAPI().EXPECT().List(mock.Anything, compute.ListClustersRequest{}).Return(&listing.SliceIterator[compute.ClusterDetails]{
+			{ClusterId: "abc", ClusterName: "Shared", ClusterSource: compute.ClusterSourceUi},
+			{ClusterId: "job-run", ClusterName: "job-123-run-1", ClusterSource: compute.ClusterSourceJob},
+			{ClusterId: "def", ClusterName: "Automation", ClusterSource: compute.ClusterSourceApi},
+		})
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		results := listThroughProvider(t, client, ListResourceCluster, "databricks_cluster", nil, false, 0)
+		require.Len(t, results, 2)
+		assert.Equal(t, "Shared", results[0].displayName)
+		assert.Equal(t, tftypes.NewValue(tftypes.String, "abc"), results[0].identity["cluster_id"])
+		assert.Nil(t, results[0].resource)
+		assert.Equal(t, "Automation", results[1].displayName)
+		assert.Equal(t, tftypes.NewValue(tftypes.String, "def"), results[1].identity["cluster_id"])
+	})
+}
+
+func TestListSchemas(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		w.GetMockSchemasAPI().EXPECT().List(mock.Anything, catalog.ListSchemasRequest{CatalogName: "main"}).Return(&listing.SliceIterator[catalog.SchemaInfo]{
+			{Name: "information_schema", FullName: "main.information_schema"},
+			{Name: "default", FullName: "main.default"},
+			{Name: "sales", FullName: "main.sales"},
+		})
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		results := listThroughProvider(t, client, ListResourceSchema, "databricks_schema", map[string]tftypes.Value{
+			"catalog_name": tftypes.NewValue(tftypes.String, "main"),
+		}, false, 1)
+		require.Len(t, results, 1)
+		assert.Equal(t, "main.default", results[0].displayName)
+		assert.Equal(t, tftypes.NewValue(tftypes.String, "main"), results[0].identity["catalog_name"])
+		assert.Equal(t, tftypes.NewValue(tftypes.String, "default"), results[0].identity["name"])
+	})
+}
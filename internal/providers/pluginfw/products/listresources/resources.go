@@ -0,0 +1,183 @@
+package listresources
+
+import (
+	"context"
+	"iter"
+	"strconv"
+
+	"github.com/databricks/databricks-sdk-go"
+	catalogsdk "github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	jobssdk "github.com/databricks/databricks-sdk-go/service/jobs"
+	pipelinessdk "github.com/databricks/databricks-sdk-go/service/pipelines"
+	sqlsdk "github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/terraform-provider-databricks/catalog"
+	"github.com/databricks/terraform-provider-databricks/clusters"
+	"github.com/databricks/terraform-provider-databricks/jobs"
+	"github.com/databricks/terraform-provider-databricks/pipelines"
+	"github.com/databricks/terraform-provider-databricks/policies"
+	"github.com/databricks/terraform-provider-databricks/pools"
+	"github.com/databricks/terraform-provider-databricks/sql"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ListResourceCatalog lists catalogs, except for system and internal ones
+func ListResourceCatalog() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "catalog",
+		resource: catalog.ResourceCatalog(),
+		list: func(ctx context.Context, w *databricks.WorkspaceClient, _ tfsdk.Config) (iter.Seq2[listedResource, error], diag.Diagnostics) {
+			return listed(ctx, w.Catalogs.List(ctx, catalogsdk.ListCatalogsRequest{}), func(c catalogsdk.CatalogInfo) (listedResource, bool) {
+				if c.CatalogType == catalogsdk.CatalogTypeSystemCatalog || c.CatalogType == catalogsdk.CatalogTypeInternalCatalog {
+					return listedResource{}, false
+				}
+				return listedResource{id: c.Name, displayName: c.Name}, true
+			}), nil
+		},
+	}
+}
+
+// ListResourceCluster lists all-purpose clusters, skipping clusters created by jobs and pipelines
+func ListResourceCluster() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "cluster",
+		resource: clusters.ResourceCluster(),
+		list: func(ctx context.Context, w *databricks.WorkspaceClient, _ tfsdk.Config) (iter.Seq2[listedResource, error], diag.Diagnostics) {
+			return listed(ctx, w.Clusters.List(ctx, compute.ListClustersRequest{}), func(c compute.ClusterDetails) (listedResource, bool) {
+				switch c.ClusterSource {
+				case compute.ClusterSourceJob, compute.ClusterSourcePipeline, compute.ClusterSourcePipelineMaintenance:
+					return listedResource{}, false
+				}
+				return listedResource{id: c.ClusterId, displayName: c.ClusterName}, true
+			}), nil
+		},
+	}
+}
+
+// ListResourceClusterPolicy lists cluster policies
+func ListResourceClusterPolicy() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "cluster_policy",
+		resource: policies.ResourceClusterPolicy(),
+		list: func(ctx context.Context, w *databricks.WorkspaceClient, _ tfsdk.Config) (iter.Seq2[listedResource, error], diag.Diagnostics) {
+			return listed(ctx, w.ClusterPolicies.List(ctx, compute.ListClusterPoliciesRequest{}), func(p compute.Policy) (listedResource, bool) {
+				return listedResource{id: p.PolicyId, displayName: p.Name}, true
+			}), nil
+		},
+	}
+}
+
+// ListResourceExternalLocation lists external locations
+func ListResourceExternalLocation() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "external_location",
+		resource: catalog.ResourceExternalLocation(),
+		list: func(ctx context.Context, w *databricks.WorkspaceClient, _ tfsdk.Config) (iter.Seq2[listedResource, error], diag.Diagnostics) {
+			return listed(ctx, w.ExternalLocations.List(ctx, catalogsdk.ListExternalLocationsRequest{}), func(l catalogsdk.ExternalLocationInfo) (listedResource, bool) {
+				return listedResource{id: l.Name, displayName: l.Name}, true
+			}), nil
+		},
+	}
+}
+
+// ListResourceInstancePool lists instance pools
+func ListResourceInstancePool() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "instance_pool",
+		resource: pools.ResourceInstancePool(),
+		list: func(ctx context.Context, w *databricks.WorkspaceClient, _ tfsdk.Config) (iter.Seq2[listedResource, error], diag.Diagnostics) {
+			return listed(ctx, w.InstancePools.List(ctx), func(p compute.InstancePoolAndStats) (listedResource, bool) {
+				return listedResource{id: p.InstancePoolId, displayName: p.InstancePoolName}, true
+			}), nil
+		},
+	}
+}
+
+// ListResourceJob lists jobs
+func ListResourceJob() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "job",
+		resource: jobs.ResourceJob(),
+		list: func(ctx context.Context, w *databricks.WorkspaceClient, _ tfsdk.Config) (iter.Seq2[listedResource, error], diag.Diagnostics) {
+			return listed(ctx, w.Jobs.List(ctx, jobssdk.ListJobsRequest{}), func(j jobssdk.BaseJob) (listedResource, bool) {
+				name := ""
+				if j.Settings != nil {
+					name = j.Settings.Name
+				}
+				return listedResource{id: strconv.FormatInt(j.JobId, 10), displayName: name}, true
+			}), nil
+		},
+	}
+}
+
+// ListResourcePipeline lists pipelines
+func ListResourcePipeline() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "pipeline",
+		resource: pipelines.ResourcePipeline(),
+		list: func(ctx context.Context, w *databricks.WorkspaceClient, _ tfsdk.Config) (iter.Seq2[listedResource, error], diag.Diagnostics) {
+			return listed(ctx, w.Pipelines.ListPipelines(ctx, pipelinessdk.ListPipelinesRequest{}), func(p pipelinessdk.PipelineStateInfo) (listedResource, bool) {
+				return listedResource{id: p.PipelineId, displayName: p.Name}, true
+			}), nil
+		},
+	}
+}
+
+// ListResourceSchema lists schemas of the catalog, except for `information_schema`
+func ListResourceSchema() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "schema",
+		resource: catalog.ResourceSchema(),
+		attributes: map[string]schema.Attribute{
+			"catalog_name": schema.StringAttribute{
+				Description: "Name of the catalog to list schemas of",
+				Required:    true,
+			},
+		},
+		list: func(ctx context.Context, w *databricks.WorkspaceClient, config tfsdk.Config) (iter.Seq2[listedResource, error], diag.Diagnostics) {
+			var catalogName types.String
+			diags := config.GetAttribute(ctx, path.Root("catalog_name"), &catalogName)
+			if diags.HasError() {
+				return nil, diags
+			}
+			request := catalogsdk.ListSchemasRequest{CatalogName: catalogName.ValueString()}
+			return listed(ctx, w.Schemas.List(ctx, request), func(s catalogsdk.SchemaInfo) (listedResource, bool) {
+				if s.Name == "information_schema" {
+					return listedResource{}, false
+				}
+				return listedResource{id: s.FullName, displayName: s.FullName}, true
+			}), diags
+		},
+	}
+}
+
+// ListResourceSqlEndpoint lists SQL warehouses
+func ListResourceSqlEndpoint() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "sql_endpoint",
+		resource: sql.ResourceSqlEndpoint(),
+		list: func(ctx context.Context, w *databricks.WorkspaceClient, _ tfsdk.Config) (iter.Seq2[listedResource, error], diag.Diagnostics) {
+			return listed(ctx, w.Warehouses.List(ctx, sqlsdk.ListWarehousesRequest{}), func(e sqlsdk.EndpointInfo) (listedResource, bool) {
+				return listedResource{id: e.Id, displayName: e.Name}, true
+			}), nil
+		},
+	}
+}
+
+// ListResourceStorageCredential lists storage credentials
+func ListResourceStorageCredential() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "storage_credential",
+		resource: catalog.ResourceStorageCredential(),
+		list: func(ctx context.Context, w *databricks.WorkspaceClient, _ tfsdk.Config) (iter.Seq2[listedResource, error], diag.Diagnostics) {
+			return listed(ctx, w.StorageCredentials.List(ctx, catalogsdk.ListStorageCredentialsRequest{}), func(c catalogsdk.StorageCredentialInfo) (listedResource, bool) {
+				return listedResource{id: c.Name, displayName: c.Name}, true
+			}), nil
+		},
+	}
+}
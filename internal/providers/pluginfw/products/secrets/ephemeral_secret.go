@@ -0,0 +1,100 @@
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const secretName = "secret"
+
+func EphemeralSecret() ephemeral.EphemeralResource {
+	return &SecretEphemeralResource{}
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &SecretEphemeralResource{}
+
+// SecretEphemeralResource reads the value of a secret without saving it to the state or the plan
+type SecretEphemeralResource struct {
+	Client *common.DatabricksClient
+}
+
+type SecretEphemeral struct {
+	Scope types.String `tfsdk:"scope"`
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (r *SecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(secretName)
+}
+
+func (r *SecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Value of a secret in a secret scope",
+		Attributes: map[string]schema.Attribute{
+			"scope": schema.StringAttribute{
+				Required: true,
+			},
+			"key": schema.StringAttribute{
+				Required: true,
+			},
+			"value": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (r *SecretEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if r.Client == nil {
+		r.Client = pluginfwcommon.ConfigureEphemeralResource(req, resp)
+	}
+}
+
+func readSecret(ctx context.Context, w *databricks.WorkspaceClient, secret *SecretEphemeral) error {
+	resp, err := w.Secrets.GetSecret(ctx, workspace.GetSecretRequest{
+		Scope: secret.Scope.ValueString(),
+		Key:   secret.Key.ValueString(),
+	})
+	if err != nil {
+		return err
+	}
+	// the API returns base64-encoded bytes of the secret
+	value, err := base64.StdEncoding.DecodeString(resp.Value)
+	if err != nil {
+		return fmt.Errorf("cannot decode secret: %w", err)
+	}
+	secret.Value = types.StringValue(string(value))
+	return nil
+}
+
+func (r *SecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx = pluginfwcontext.SetUserAgentInEphemeralResourceContext(ctx, secretName)
+	w, diags := r.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var secret SecretEphemeral
+	resp.Diagnostics.Append(req.Config.Get(ctx, &secret)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := readSecret(ctx, w, &secret); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read secret %s in scope %s",
+			secret.Key.ValueString(), secret.Scope.ValueString()), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, secret)...)
+}
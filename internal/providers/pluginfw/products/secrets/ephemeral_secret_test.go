@@ -0,0 +1,47 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadSecret(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockSecretsAPI().EXPECT().GetSecret(mock.Anything, workspace.GetSecretRequest{
+		Scope: "vault",
+		Key:   "token",
+	}).Return(&workspace.GetSecretResponse{
+		Key:   "token",
+		Value: "c2VjcmV0", // secret
+	}, nil)
+	secret := SecretEphemeral{
+		Scope: types.StringValue("vault"),
+		Key:   types.StringValue("token"),
+	}
+	err := readSecret(context.Background(), w.WorkspaceClient, &secret)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", secret.Value.ValueString())
+}
+
+func TestReadSecret_InvalidValue(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockSecretsAPI().EXPECT().GetSecret(mock.Anything, workspace.GetSecretRequest{
+		Scope: "vault",
+		Key:   "token",
+	}).Return(&workspace.GetSecretResponse{
+		Value: "not base64!",
+	}, nil)
+	secret := SecretEphemeral{
+		Scope: types.StringValue("vault"),
+		Key:   types.StringValue("token"),
+	}
+	err := readSecret(context.Background(), w.WorkspaceClient, &secret)
+	assert.ErrorContains(t, err, "cannot decode secret")
+}
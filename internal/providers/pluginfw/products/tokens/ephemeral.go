@@ -0,0 +1,77 @@
+package tokens
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// defaultLifetimeSeconds limits the lifetime of ephemeral credentials, so that they expire even if
+// Terraform doesn't get to close them, e.g. when it's interrupted
+const defaultLifetimeSeconds = 3600
+
+// privateKey is the key of the private data, that passes the ID of the credential from Open to Close
+const privateKey = "credential"
+
+type privateData interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// resultData is the result of Open, that is sent to Terraform
+type resultData interface {
+	Set(ctx context.Context, val any) diag.Diagnostics
+}
+
+type privateCredential struct {
+	ID string `json:"id"`
+	// ServicePrincipalID is only set for secrets of service principals
+	ServicePrincipalID string `json:"service_principal_id,omitempty"`
+}
+
+func setPrivateCredential(ctx context.Context, private privateData, credential privateCredential) diag.Diagnostics {
+	value, err := json.Marshal(credential)
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("failed to save credential ID", err.Error())}
+	}
+	return private.SetKey(ctx, privateKey, value)
+}
+
+func getPrivateCredential(ctx context.Context, private privateData) (*privateCredential, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, privateKey)
+	if diags.HasError() || value == nil {
+		return nil, diags
+	}
+	var credential privateCredential
+	if err := json.Unmarshal(value, &credential); err != nil {
+		diags.AddError("failed to read credential ID", err.Error())
+		return nil, diags
+	}
+	return &credential, diags
+}
+
+// setOpenResult sets the result of Open and saves the ID of the credential for Close. Close isn't called when
+// Open fails, so the credential is revoked right away, if any of them can't be set.
+func setOpenResult(ctx context.Context, result resultData, value any, private privateData,
+	credential privateCredential, revoke func() error) diag.Diagnostics {
+	diags := result.Set(ctx, value)
+	if !diags.HasError() {
+		diags.Append(setPrivateCredential(ctx, private, credential)...)
+	}
+	if diags.HasError() {
+		if err := revoke(); err != nil {
+			diags.AddWarning("failed to revoke credential", err.Error())
+		}
+	}
+	return diags
+}
+
+// ignoreMissing treats credentials that are already revoked or expired as closed
+func ignoreMissing(err error) error {
+	if apierr.IsMissing(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,140 @@
+package tokens
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const oboTokenName = "obo_token"
+
+func EphemeralOboToken() ephemeral.EphemeralResource {
+	return &OboTokenEphemeralResource{}
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &OboTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &OboTokenEphemeralResource{}
+
+// OboTokenEphemeralResource creates an on-behalf-of token for a service principal for the duration of the run
+type OboTokenEphemeralResource struct {
+	Client *common.DatabricksClient
+}
+
+type OboTokenEphemeral struct {
+	ApplicationId   types.String `tfsdk:"application_id"`
+	Comment         types.String `tfsdk:"comment"`
+	LifetimeSeconds types.Int64  `tfsdk:"lifetime_seconds"`
+	TokenId         types.String `tfsdk:"token_id"`
+	TokenValue      types.String `tfsdk:"token_value"`
+	ExpiryTime      types.Int64  `tfsdk:"expiry_time"`
+}
+
+func (r *OboTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(oboTokenName)
+}
+
+func (r *OboTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "On-behalf-of token of a service principal, that is revoked at the end of the run",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required: true,
+			},
+			"comment": schema.StringAttribute{
+				Optional: true,
+			},
+			"lifetime_seconds": schema.Int64Attribute{
+				Optional: true,
+			},
+			"token_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"token_value": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expiry_time": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *OboTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if r.Client == nil {
+		r.Client = pluginfwcommon.ConfigureEphemeralResource(req, resp)
+	}
+}
+
+func createOboToken(ctx context.Context, w *databricks.WorkspaceClient, token *OboTokenEphemeral) error {
+	lifetime := token.LifetimeSeconds.ValueInt64()
+	if token.LifetimeSeconds.IsNull() {
+		lifetime = defaultLifetimeSeconds
+	}
+	resp, err := w.TokenManagement.CreateOboToken(ctx, settings.CreateOboTokenRequest{
+		ApplicationId:   token.ApplicationId.ValueString(),
+		Comment:         token.Comment.ValueString(),
+		LifetimeSeconds: lifetime,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.TokenInfo == nil || resp.TokenInfo.TokenId == "" {
+		return errTokenIdMissing
+	}
+	token.TokenValue = types.StringValue(resp.TokenValue)
+	token.TokenId = types.StringValue(resp.TokenInfo.TokenId)
+	token.ExpiryTime = types.Int64Value(resp.TokenInfo.ExpiryTime)
+	return nil
+}
+
+func revokeOboToken(ctx context.Context, w *databricks.WorkspaceClient, tokenID string) error {
+	return ignoreMissing(w.TokenManagement.DeleteByTokenId(ctx, tokenID))
+}
+
+func (r *OboTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx = pluginfwcontext.SetUserAgentInEphemeralResourceContext(ctx, oboTokenName)
+	w, diags := r.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var token OboTokenEphemeral
+	resp.Diagnostics.Append(req.Config.Get(ctx, &token)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := createOboToken(ctx, w, &token); err != nil {
+		resp.Diagnostics.AddError("failed to create on-behalf-of token", err.Error())
+		return
+	}
+	tokenID := token.TokenId.ValueString()
+	resp.Diagnostics.Append(setOpenResult(ctx, &resp.Result, token, resp.Private, privateCredential{ID: tokenID},
+		func() error {
+			return revokeOboToken(ctx, w, tokenID)
+		})...)
+}
+
+func (r *OboTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	ctx = pluginfwcontext.SetUserAgentInEphemeralResourceContext(ctx, oboTokenName)
+	credential, diags := getPrivateCredential(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || credential == nil {
+		return
+	}
+	w, diags := r.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := revokeOboToken(ctx, w, credential.ID); err != nil {
+		resp.Diagnostics.AddError("failed to revoke on-behalf-of token", err.Error())
+	}
+}
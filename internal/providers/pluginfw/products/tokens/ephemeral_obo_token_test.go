@@ -0,0 +1,45 @@
+package tokens
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateOboToken(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockTokenManagementAPI().EXPECT().CreateOboToken(mock.Anything, settings.CreateOboTokenRequest{
+		ApplicationId:   "app1",
+		LifetimeSeconds: 3600,
+	}).Return(&settings.CreateOboTokenResponse{
+		TokenValue: "dapi456",
+		TokenInfo: &settings.TokenInfo{
+			TokenId:    "t2",
+			ExpiryTime: 1700000000000,
+		},
+	}, nil)
+	token := OboTokenEphemeral{
+		ApplicationId:   types.StringValue("app1"),
+		Comment:         types.StringNull(),
+		LifetimeSeconds: types.Int64Null(),
+	}
+	err := createOboToken(context.Background(), w.WorkspaceClient, &token)
+	require.NoError(t, err)
+	assert.Equal(t, "t2", token.TokenId.ValueString())
+	assert.Equal(t, "dapi456", token.TokenValue.ValueString())
+}
+
+func TestRevokeOboToken(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockTokenManagementAPI().EXPECT().DeleteByTokenId(mock.Anything, "t2").Return(&apierr.APIError{
+		Message: "internal error",
+	})
+	assert.EqualError(t, revokeOboToken(context.Background(), w.WorkspaceClient, "t2"), "internal error")
+}
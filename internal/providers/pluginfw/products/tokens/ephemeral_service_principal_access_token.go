@@ -0,0 +1,180 @@
+package tokens
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/config"
+	"github.com/databricks/databricks-sdk-go/service/oauth2"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const servicePrincipalAccessTokenName = "service_principal_access_token"
+
+func EphemeralServicePrincipalAccessToken() ephemeral.EphemeralResource {
+	return &ServicePrincipalAccessTokenEphemeralResource{}
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &ServicePrincipalAccessTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &ServicePrincipalAccessTokenEphemeralResource{}
+
+// ServicePrincipalAccessTokenEphemeralResource creates a temporary OAuth secret of a service principal,
+// exchanges it for an OAuth M2M access token, and deletes the secret at the end of the run.
+type ServicePrincipalAccessTokenEphemeralResource struct {
+	Client *common.DatabricksClient
+}
+
+type ServicePrincipalAccessTokenEphemeral struct {
+	ServicePrincipalId types.String `tfsdk:"service_principal_id"`
+	ApplicationId      types.String `tfsdk:"application_id"`
+	LifetimeSeconds    types.Int64  `tfsdk:"lifetime_seconds"`
+	AccessToken        types.String `tfsdk:"access_token"`
+	TokenType          types.String `tfsdk:"token_type"`
+	ExpiryTime         types.Int64  `tfsdk:"expiry_time"`
+}
+
+func (r *ServicePrincipalAccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(servicePrincipalAccessTokenName)
+}
+
+func (r *ServicePrincipalAccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "OAuth M2M access token of a service principal, issued with a temporary secret that is deleted at the end of the run",
+		Attributes: map[string]schema.Attribute{
+			"service_principal_id": schema.StringAttribute{
+				Required: true,
+			},
+			"application_id": schema.StringAttribute{
+				Required: true,
+			},
+			"lifetime_seconds": schema.Int64Attribute{
+				Optional: true,
+			},
+			"access_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"expiry_time": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *ServicePrincipalAccessTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if r.Client == nil {
+		r.Client = pluginfwcommon.ConfigureEphemeralResource(req, resp)
+	}
+}
+
+// exchangeClientCredentials gets an access token with the OAuth client credentials flow, using the
+// same discovery of OIDC endpoints as the Go SDK
+func exchangeClientCredentials(ctx context.Context, w *databricks.WorkspaceClient, clientID, clientSecret string) (*ServicePrincipalAccessTokenEphemeral, error) {
+	cfg := &config.Config{
+		Host:          w.Config.Host,
+		ClientID:      clientID,
+		ClientSecret:  clientSecret,
+		Credentials:   config.M2mCredentials{},
+		HTTPTransport: w.Config.HTTPTransport,
+	}
+	token, err := cfg.GetTokenSource().Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ServicePrincipalAccessTokenEphemeral{
+		AccessToken: types.StringValue(token.AccessToken),
+		TokenType:   types.StringValue(token.TokenType),
+		ExpiryTime:  types.Int64Value(token.Expiry.UnixMilli()),
+	}, nil
+}
+
+// createServicePrincipalAccessToken returns the ID of the temporary secret, that has to be deleted when it's not
+// empty, even if there is an error
+func createServicePrincipalAccessToken(ctx context.Context, w *databricks.WorkspaceClient,
+	token *ServicePrincipalAccessTokenEphemeral) (string, error) {
+	lifetime := token.LifetimeSeconds.ValueInt64()
+	if token.LifetimeSeconds.IsNull() {
+		lifetime = defaultLifetimeSeconds
+	}
+	secret, err := w.ServicePrincipalSecretsProxy.Create(ctx, oauth2.CreateServicePrincipalSecretRequest{
+		ServicePrincipalId: token.ServicePrincipalId.ValueString(),
+		Lifetime:           fmt.Sprintf("%ds", lifetime),
+	})
+	if err != nil {
+		return "", err
+	}
+	if secret.Id == "" {
+		return "", fmt.Errorf("secret ID isn't returned, so the secret can't be deleted at the end of the run")
+	}
+	exchanged, err := exchangeClientCredentials(ctx, w, token.ApplicationId.ValueString(), secret.Secret)
+	if err != nil {
+		return secret.Id, fmt.Errorf("cannot get access token: %w", err)
+	}
+	token.AccessToken = exchanged.AccessToken
+	token.TokenType = exchanged.TokenType
+	token.ExpiryTime = exchanged.ExpiryTime
+	return secret.Id, nil
+}
+
+func deleteServicePrincipalSecret(ctx context.Context, w *databricks.WorkspaceClient, credential privateCredential) error {
+	return ignoreMissing(w.ServicePrincipalSecretsProxy.Delete(ctx, oauth2.DeleteServicePrincipalSecretRequest{
+		ServicePrincipalId: credential.ServicePrincipalID,
+		SecretId:           credential.ID,
+	}))
+}
+
+func (r *ServicePrincipalAccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx = pluginfwcontext.SetUserAgentInEphemeralResourceContext(ctx, servicePrincipalAccessTokenName)
+	w, diags := r.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var token ServicePrincipalAccessTokenEphemeral
+	resp.Diagnostics.Append(req.Config.Get(ctx, &token)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	secretID, err := createServicePrincipalAccessToken(ctx, w, &token)
+	credential := privateCredential{ID: secretID, ServicePrincipalID: token.ServicePrincipalId.ValueString()}
+	if err != nil {
+		if secretID != "" {
+			// Close isn't called when Open fails
+			if deleteErr := deleteServicePrincipalSecret(ctx, w, credential); deleteErr != nil {
+				resp.Diagnostics.AddWarning("failed to delete temporary secret", deleteErr.Error())
+			}
+		}
+		resp.Diagnostics.AddError("failed to create service principal access token", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(setOpenResult(ctx, &resp.Result, token, resp.Private, credential, func() error {
+		return deleteServicePrincipalSecret(ctx, w, credential)
+	})...)
+}
+
+func (r *ServicePrincipalAccessTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	ctx = pluginfwcontext.SetUserAgentInEphemeralResourceContext(ctx, servicePrincipalAccessTokenName)
+	credential, diags := getPrivateCredential(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || credential == nil {
+		return
+	}
+	w, diags := r.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// access tokens can't be revoked, but they can't be refreshed without the secret either
+	if err := deleteServicePrincipalSecret(ctx, w, *credential); err != nil {
+		resp.Diagnostics.AddError("failed to delete temporary secret", err.Error())
+	}
+}
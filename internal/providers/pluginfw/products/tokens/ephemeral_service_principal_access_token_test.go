@@ -0,0 +1,105 @@
+package tokens
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/config"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/oauth2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func oidcServer(t *testing.T) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/oidc/.well-known/oauth-authorization-server":
+			json.NewEncoder(w).Encode(map[string]string{
+				"authorization_endpoint": server.URL + "/oidc/v1/authorize",
+				"token_endpoint":         server.URL + "/oidc/v1/token",
+			})
+		case "/oidc/v1/token":
+			clientID, clientSecret, _ := r.BasicAuth()
+			if clientID != "app1" || clientSecret != "dose123" {
+				w.WriteHeader(http.StatusUnauthorized)
+				json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]any{
+				"access_token": "eyJ-token",
+				"token_type":   "Bearer",
+				"expires_in":   3600,
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCreateServicePrincipalAccessToken(t *testing.T) {
+	server := oidcServer(t)
+	w := mocks.NewMockWorkspaceClient(t)
+	w.WorkspaceClient.Config = &config.Config{Host: server.URL}
+	w.GetMockServicePrincipalSecretsProxyAPI().EXPECT().Create(mock.Anything, oauth2.CreateServicePrincipalSecretRequest{
+		ServicePrincipalId: "123",
+		Lifetime:           "600s",
+	}).Return(&oauth2.CreateServicePrincipalSecretResponse{
+		Id:     "s1",
+		Secret: "dose123",
+	}, nil)
+	token := ServicePrincipalAccessTokenEphemeral{
+		ServicePrincipalId: types.StringValue("123"),
+		ApplicationId:      types.StringValue("app1"),
+		LifetimeSeconds:    types.Int64Value(600),
+	}
+	secretID, err := createServicePrincipalAccessToken(context.Background(), w.WorkspaceClient, &token)
+	require.NoError(t, err)
+	assert.Equal(t, "s1", secretID)
+	assert.Equal(t, "eyJ-token", token.AccessToken.ValueString())
+	assert.Equal(t, "Bearer", token.TokenType.ValueString())
+	assert.NotZero(t, token.ExpiryTime.ValueInt64())
+}
+
+func TestCreateServicePrincipalAccessToken_ExchangeError(t *testing.T) {
+	server := oidcServer(t)
+	w := mocks.NewMockWorkspaceClient(t)
+	w.WorkspaceClient.Config = &config.Config{Host: server.URL}
+	w.GetMockServicePrincipalSecretsProxyAPI().EXPECT().Create(mock.Anything, oauth2.CreateServicePrincipalSecretRequest{
+		ServicePrincipalId: "123",
+		Lifetime:           "3600s",
+	}).Return(&oauth2.CreateServicePrincipalSecretResponse{
+		Id:     "s1",
+		Secret: "other",
+	}, nil)
+	token := ServicePrincipalAccessTokenEphemeral{
+		ServicePrincipalId: types.StringValue("123"),
+		ApplicationId:      types.StringValue("app1"),
+		LifetimeSeconds:    types.Int64Null(),
+	}
+	secretID, err := createServicePrincipalAccessToken(context.Background(), w.WorkspaceClient, &token)
+	assert.Equal(t, "s1", secretID, "secret has to be deleted")
+	assert.ErrorContains(t, err, "cannot get access token")
+}
+
+func TestDeleteServicePrincipalSecret(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockServicePrincipalSecretsProxyAPI().EXPECT().Delete(mock.Anything, oauth2.DeleteServicePrincipalSecretRequest{
+		ServicePrincipalId: "123",
+		SecretId:           "s1",
+	}).Return(nil)
+	err := deleteServicePrincipalSecret(context.Background(), w.WorkspaceClient, privateCredential{
+		ID:                 "s1",
+		ServicePrincipalID: "123",
+	})
+	assert.NoError(t, err)
+}
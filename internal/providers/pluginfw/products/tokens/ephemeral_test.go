@@ -0,0 +1,58 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPrivateData map[string][]byte
+
+func (d testPrivateData) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return d[key], nil
+}
+
+func (d testPrivateData) SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics {
+	d[key] = value
+	return nil
+}
+
+type failingResultData struct{}
+
+func (failingResultData) Set(ctx context.Context, val any) diag.Diagnostics {
+	return diag.Diagnostics{diag.NewErrorDiagnostic("Value Conversion Error", "unexpected type")}
+}
+
+func TestSetOpenResult_RevokesOnError(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateData{}
+	revoked := false
+	diags := setOpenResult(ctx, failingResultData{}, "x", private, privateCredential{ID: "t1"}, func() error {
+		revoked = true
+		return errors.New("revocation is disabled")
+	})
+	assert.True(t, diags.HasError())
+	assert.True(t, revoked)
+	assert.Equal(t, "failed to revoke credential", diags.Warnings()[0].Summary())
+	assert.Empty(t, private)
+}
+
+func TestPrivateCredential(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateData{}
+	diags := setPrivateCredential(ctx, private, privateCredential{ID: "s1", ServicePrincipalID: "123"})
+	require.False(t, diags.HasError())
+	credential, diags := getPrivateCredential(ctx, private)
+	require.False(t, diags.HasError())
+	assert.Equal(t, &privateCredential{ID: "s1", ServicePrincipalID: "123"}, credential)
+}
+
+func TestPrivateCredential_Missing(t *testing.T) {
+	credential, diags := getPrivateCredential(context.Background(), testPrivateData{})
+	assert.False(t, diags.HasError())
+	assert.Nil(t, credential)
+}
@@ -0,0 +1,139 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const tokenName = "token"
+
+// errTokenIdMissing is returned when a token is created without an ID, as it couldn't be revoked at the end of the run
+var errTokenIdMissing = errors.New("token ID isn't returned, so the token can't be revoked at the end of the run")
+
+func EphemeralToken() ephemeral.EphemeralResource {
+	return &TokenEphemeralResource{}
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &TokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &TokenEphemeralResource{}
+
+// TokenEphemeralResource creates a personal access token of the current user for the duration of the run
+type TokenEphemeralResource struct {
+	Client *common.DatabricksClient
+}
+
+type TokenEphemeral struct {
+	Comment         types.String `tfsdk:"comment"`
+	LifetimeSeconds types.Int64  `tfsdk:"lifetime_seconds"`
+	TokenId         types.String `tfsdk:"token_id"`
+	TokenValue      types.String `tfsdk:"token_value"`
+	ExpiryTime      types.Int64  `tfsdk:"expiry_time"`
+}
+
+func (r *TokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(tokenName)
+}
+
+func (r *TokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Personal access token of the current user, that is revoked at the end of the run",
+		Attributes: map[string]schema.Attribute{
+			"comment": schema.StringAttribute{
+				Optional: true,
+			},
+			"lifetime_seconds": schema.Int64Attribute{
+				Optional: true,
+			},
+			"token_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"token_value": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expiry_time": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *TokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if r.Client == nil {
+		r.Client = pluginfwcommon.ConfigureEphemeralResource(req, resp)
+	}
+}
+
+func createToken(ctx context.Context, w *databricks.WorkspaceClient, token *TokenEphemeral) error {
+	lifetime := token.LifetimeSeconds.ValueInt64()
+	if token.LifetimeSeconds.IsNull() {
+		lifetime = defaultLifetimeSeconds
+	}
+	resp, err := w.Tokens.Create(ctx, settings.CreateTokenRequest{
+		Comment:         token.Comment.ValueString(),
+		LifetimeSeconds: lifetime,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.TokenInfo == nil || resp.TokenInfo.TokenId == "" {
+		return errTokenIdMissing
+	}
+	token.TokenValue = types.StringValue(resp.TokenValue)
+	token.TokenId = types.StringValue(resp.TokenInfo.TokenId)
+	token.ExpiryTime = types.Int64Value(resp.TokenInfo.ExpiryTime)
+	return nil
+}
+
+func revokeToken(ctx context.Context, w *databricks.WorkspaceClient, tokenID string) error {
+	return ignoreMissing(w.Tokens.DeleteByTokenId(ctx, tokenID))
+}
+
+func (r *TokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx = pluginfwcontext.SetUserAgentInEphemeralResourceContext(ctx, tokenName)
+	w, diags := r.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var token TokenEphemeral
+	resp.Diagnostics.Append(req.Config.Get(ctx, &token)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := createToken(ctx, w, &token); err != nil {
+		resp.Diagnostics.AddError("failed to create token", err.Error())
+		return
+	}
+	tokenID := token.TokenId.ValueString()
+	resp.Diagnostics.Append(setOpenResult(ctx, &resp.Result, token, resp.Private, privateCredential{ID: tokenID},
+		func() error {
+			return revokeToken(ctx, w, tokenID)
+		})...)
+}
+
+func (r *TokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	ctx = pluginfwcontext.SetUserAgentInEphemeralResourceContext(ctx, tokenName)
+	credential, diags := getPrivateCredential(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || credential == nil {
+		return
+	}
+	w, diags := r.Client.GetWorkspaceClient()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := revokeToken(ctx, w, credential.ID); err != nil {
+		resp.Diagnostics.AddError("failed to revoke token", err.Error())
+	}
+}
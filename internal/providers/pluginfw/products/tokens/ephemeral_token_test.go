@@ -0,0 +1,76 @@
+package tokens
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateToken(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockTokensAPI().EXPECT().Create(mock.Anything, settings.CreateTokenRequest{
+		Comment:         "vault",
+		LifetimeSeconds: 3600,
+	}).Return(&settings.CreateTokenResponse{
+		TokenValue: "dapi123",
+		TokenInfo: &settings.PublicTokenInfo{
+			TokenId:    "t1",
+			ExpiryTime: 1700000000000,
+		},
+	}, nil)
+	token := TokenEphemeral{
+		Comment:         types.StringValue("vault"),
+		LifetimeSeconds: types.Int64Null(),
+	}
+	err := createToken(context.Background(), w.WorkspaceClient, &token)
+	require.NoError(t, err)
+	assert.Equal(t, "t1", token.TokenId.ValueString())
+	assert.Equal(t, "dapi123", token.TokenValue.ValueString())
+	assert.Equal(t, int64(1700000000000), token.ExpiryTime.ValueInt64())
+}
+
+func TestCreateToken_Error(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockTokensAPI().EXPECT().Create(mock.Anything, settings.CreateTokenRequest{
+		LifetimeSeconds: 600,
+	}).Return(nil, &apierr.APIError{Message: "tokens are disabled"})
+	token := TokenEphemeral{
+		Comment:         types.StringNull(),
+		LifetimeSeconds: types.Int64Value(600),
+	}
+	err := createToken(context.Background(), w.WorkspaceClient, &token)
+	assert.EqualError(t, err, "tokens are disabled")
+}
+
+func TestCreateToken_NoTokenId(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockTokensAPI().EXPECT().Create(mock.Anything, settings.CreateTokenRequest{
+		LifetimeSeconds: 600,
+	}).Return(&settings.CreateTokenResponse{TokenValue: "dapi123"}, nil)
+	token := TokenEphemeral{
+		Comment:         types.StringNull(),
+		LifetimeSeconds: types.Int64Value(600),
+	}
+	err := createToken(context.Background(), w.WorkspaceClient, &token)
+	assert.ErrorIs(t, err, errTokenIdMissing)
+	assert.True(t, token.TokenValue.IsNull())
+}
+
+func TestRevokeToken(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockTokensAPI().EXPECT().DeleteByTokenId(mock.Anything, "t1").Return(nil)
+	assert.NoError(t, revokeToken(context.Background(), w.WorkspaceClient, "t1"))
+}
+
+func TestRevokeToken_AlreadyRevoked(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockTokensAPI().EXPECT().DeleteByTokenId(mock.Anything, "t1").Return(apierr.ErrNotFound)
+	assert.NoError(t, revokeToken(context.Background(), w.WorkspaceClient, "t1"))
+}
@@ -92,7 +92,9 @@ func GetProviderServer(ctx context.Context, options ...ServerOption) (tfprotov6.
 		func() tfprotov6.ProviderServer {
 			return upgradedSdkPluginProvider
 		},
-		providerserver.NewProtocol6(pluginFrameworkProvider),
+		func() tfprotov6.ProviderServer {
+			return newTracedProviderServer(providerserver.NewProtocol6(pluginFrameworkProvider)())
+		},
 	}
 
 	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
@@ -101,5 +103,5 @@ func GetProviderServer(ctx context.Context, options ...ServerOption) (tfprotov6.
 		return nil, err
 	}
 
-	return muxServer.ProviderServer(), nil
+	return newProtectedProviderServer(muxServer.ProviderServer()), nil
 }
@@ -13,6 +13,7 @@ import (
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/sdkv2"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -458,3 +459,20 @@ func Test_ParseUserAgentExtra(t *testing.T) {
 		})
 	}
 }
+
+func TestListResourcesAreServedForSdkV2Resources(t *testing.T) {
+	ctx := context.Background()
+	server, err := GetProviderServer(ctx)
+	require.NoError(t, err)
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, schemas.Diagnostics)
+	for _, name := range []string{"databricks_catalog", "databricks_cluster", "databricks_job", "databricks_schema"} {
+		assert.Contains(t, schemas.ListResourceSchemas, name)
+		assert.Contains(t, schemas.ResourceSchemas, name)
+	}
+	metadata, err := server.GetMetadata(ctx, &tfprotov6.GetMetadataRequest{})
+	require.NoError(t, err)
+	require.Empty(t, metadata.Diagnostics)
+	assert.Contains(t, metadata.ListResources, tfprotov6.ListResourceMetadata{TypeName: "databricks_cluster"})
+}
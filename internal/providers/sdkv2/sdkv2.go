@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -23,11 +24,13 @@ import (
 	"github.com/databricks/terraform-provider-databricks/access"
 	"github.com/databricks/terraform-provider-databricks/apps"
 	"github.com/databricks/terraform-provider-databricks/aws"
+	"github.com/databricks/terraform-provider-databricks/azure"
 	"github.com/databricks/terraform-provider-databricks/catalog"
 	"github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/dashboards"
 	"github.com/databricks/terraform-provider-databricks/finops"
+	"github.com/databricks/terraform-provider-databricks/gcp"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/client"
 	providercommon "github.com/databricks/terraform-provider-databricks/internal/providers/common"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw"
@@ -113,7 +116,9 @@ func DatabricksProvider(opts ...SdkV2ProviderOption) *schema.Provider {
 		"databricks_aws_bucket_policy":                    aws.DataAwsBucketPolicy().ToResource(),
 		"databricks_aws_unity_catalog_assume_role_policy": aws.DataAwsUnityCatalogAssumeRolePolicy().ToResource(),
 		"databricks_aws_unity_catalog_policy":             aws.DataAwsUnityCatalogPolicy().ToResource(),
+		"databricks_azure_unity_catalog_policy":           azure.DataAzureUnityCatalogPolicy().ToResource(),
 		"databricks_cluster":                              clusters.DataSourceCluster().ToResource(),
+		"databricks_cluster_command":                      clusters.DataSourceClusterCommand().ToResource(),
 		"databricks_clusters":                             clusters.DataSourceClusters().ToResource(),
 		"databricks_cluster_policy":                       policies.DataSourceClusterPolicy().ToResource(),
 		"databricks_catalog":                              catalog.DataSourceCatalog().ToResource(),
@@ -126,6 +131,8 @@ func DatabricksProvider(opts ...SdkV2ProviderOption) *schema.Provider {
 		"databricks_directory":                            workspace.DataSourceDirectory().ToResource(),
 		"databricks_external_location":                    catalog.DataSourceExternalLocation().ToResource(),
 		"databricks_external_locations":                   catalog.DataSourceExternalLocations().ToResource(),
+		"databricks_gcp_unity_catalog_bucket_policy":      gcp.DataGcpUnityCatalogBucketPolicy().ToResource(),
+		"databricks_gcp_workspace_role":                   gcp.DataGcpWorkspaceRole().ToResource(),
 		"databricks_group":                                scim.DataSourceGroup().ToResource(),
 		"databricks_instance_pool":                        pools.DataSourceInstancePool().ToResource(),
 		"databricks_instance_profiles":                    aws.DataSourceInstanceProfiles().ToResource(),
@@ -137,13 +144,28 @@ func DatabricksProvider(opts ...SdkV2ProviderOption) *schema.Provider {
 		"databricks_mlflow_model":                         mlflow.DataSourceModel().ToResource(),
 		"databricks_mlflow_models":                        mlflow.DataSourceModels().ToResource(),
 		"databricks_mws_credentials":                      mws.DataSourceMwsCredentials().ToResource(),
+		"databricks_mws_customer_managed_key":             mws.DataSourceMwsCustomerManagedKey().ToResource(),
+		"databricks_mws_customer_managed_keys":            mws.DataSourceMwsCustomerManagedKeys().ToResource(),
+		"databricks_mws_log_deliveries":                   mws.DataSourceMwsLogDeliveries().ToResource(),
+		"databricks_mws_log_delivery":                     mws.DataSourceMwsLogDelivery().ToResource(),
+		"databricks_mws_network":                          mws.DataSourceMwsNetwork().ToResource(),
 		"databricks_mws_network_connectivity_config":      mws.DataSourceMwsNetworkConnectivityConfig().ToResource(),
 		"databricks_mws_network_connectivity_configs":     mws.DataSourceMwsNetworkConnectivityConfigs().ToResource(),
+		"databricks_mws_networks":                         mws.DataSourceMwsNetworks().ToResource(),
+		"databricks_mws_private_access_setting":           mws.DataSourceMwsPrivateAccessSetting().ToResource(),
+		"databricks_mws_private_access_settings":          mws.DataSourceMwsPrivateAccessSettings().ToResource(),
+		"databricks_mws_storage_configuration":            mws.DataSourceMwsStorageConfiguration().ToResource(),
+		"databricks_mws_storage_configurations":           mws.DataSourceMwsStorageConfigurations().ToResource(),
+		"databricks_mws_vpc_endpoint":                     mws.DataSourceMwsVpcEndpoint().ToResource(),
+		"databricks_mws_vpc_endpoints":                    mws.DataSourceMwsVpcEndpoints().ToResource(),
 		"databricks_mws_workspaces":                       mws.DataSourceMwsWorkspaces().ToResource(),
 		"databricks_node_type":                            clusters.DataSourceNodeType().ToResource(),
 		"databricks_notebook":                             workspace.DataSourceNotebook().ToResource(),
 		"databricks_notebook_paths":                       workspace.DataSourceNotebookPaths().ToResource(),
 		"databricks_pipelines":                            pipelines.DataSourcePipelines().ToResource(),
+		"databricks_provider":                             sharing.DataSourceProvider().ToResource(),
+		"databricks_provider_shares":                      sharing.DataSourceProviderShares().ToResource(),
+		"databricks_providers":                            sharing.DataSourceProviders().ToResource(),
 		"databricks_schema":                               catalog.DataSourceSchema().ToResource(),
 		"databricks_schemas":                              catalog.DataSourceSchemas().ToResource(),
 		"databricks_service_principal":                    scim.DataSourceServicePrincipal().ToResource(),
@@ -151,6 +173,7 @@ func DatabricksProvider(opts ...SdkV2ProviderOption) *schema.Provider {
 		"databricks_share":                                sharing.DataSourceShare().ToResource(),
 		"databricks_shares":                               sharing.DataSourceShares().ToResource(),
 		"databricks_spark_version":                        clusters.DataSourceSparkVersion().ToResource(),
+		"databricks_sql_query_result":                     catalog.DataSourceSqlQueryResult().ToResource(),
 		"databricks_sql_warehouse":                        sql.DataSourceWarehouse().ToResource(),
 		"databricks_sql_warehouses":                       sql.DataSourceWarehouses().ToResource(),
 		"databricks_storage_credential":                   catalog.DataSourceStorageCredential().ToResource(),
@@ -179,6 +202,7 @@ func DatabricksProvider(opts ...SdkV2ProviderOption) *schema.Provider {
 		"databricks_custom_app_integration":               apps.ResourceCustomAppIntegration().ToResource(),
 		"databricks_connection":                           catalog.ResourceConnection().ToResource(),
 		"databricks_cluster":                              clusters.ResourceCluster().ToResource(),
+		"databricks_cluster_command":                      clusters.ResourceClusterCommand().ToResource(),
 		"databricks_cluster_policy":                       policies.ResourceClusterPolicy().ToResource(),
 		"databricks_dashboard":                            dashboards.ResourceDashboard().ToResource(),
 		"databricks_dbfs_file":                            storage.ResourceDbfsFile().ToResource(),
@@ -228,6 +252,7 @@ func DatabricksProvider(opts ...SdkV2ProviderOption) *schema.Provider {
 		"databricks_permission_assignment":                access.ResourcePermissionAssignment().ToResource(),
 		"databricks_permissions":                          permissions.ResourcePermissions().ToResource(),
 		"databricks_pipeline":                             pipelines.ResourcePipeline().ToResource(),
+		"databricks_pipeline_update":                      pipelines.ResourcePipelineUpdate().ToResource(),
 		"databricks_provider":                             sharing.ResourceProvider().ToResource(),
 		"databricks_quality_monitor":                      catalog.ResourceQualityMonitor().ToResource(),
 		"databricks_query":                                sql.ResourceQuery().ToResource(),
@@ -248,6 +273,7 @@ func DatabricksProvider(opts ...SdkV2ProviderOption) *schema.Provider {
 		"databricks_sql_permissions":                      access.ResourceSqlPermissions().ToResource(),
 		"databricks_sql_query":                            sql.ResourceSqlQuery().ToResource(),
 		"databricks_sql_alert":                            sql.ResourceSqlAlert().ToResource(),
+		"databricks_sql_statement":                        catalog.ResourceSqlStatement().ToResource(),
 		"databricks_sql_table":                            catalog.ResourceSqlTable().ToResource(),
 		"databricks_sql_visualization":                    sql.ResourceSqlVisualization().ToResource(),
 		"databricks_sql_widget":                           sql.ResourceSqlWidget().ToResource(),
@@ -316,9 +342,69 @@ func providerSchema() map[string]*schema.Schema {
 		}
 		ps[attr.Name] = fieldSchema
 	}
+	ps[client.SelfIpAttribute] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	ps[client.BulkReadCacheAttribute] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+	ps[client.AuditLogPathAttribute] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	ps[client.AuditLogRequestBodiesAttribute] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+	ps[client.AuditLogHmacKeyAttribute] = &schema.Schema{
+		Type:      schema.TypeString,
+		Optional:  true,
+		Sensitive: true,
+	}
+	ps[client.ProtectedResourceTypesAttribute] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	for _, name := range []string{client.MaxRetriesAttribute, client.RetryMinBackoffAttribute,
+		client.RetryMaxBackoffAttribute, client.HostRateLimitAttribute} {
+		ps[name] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		}
+	}
+	ps[client.RetryableStatusCodesAttribute] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeInt},
+	}
+	ps[client.RetryableErrorMessagesAttribute] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
 	return ps
 }
 
+// retryPolicyFromProviderConfig reads the retry policy from provider attributes
+func retryPolicyFromProviderConfig(d *schema.ResourceData) (common.RetryPolicy, error) {
+	policy := common.RetryPolicy{
+		MaxRetries:    d.Get(client.MaxRetriesAttribute).(int),
+		MinBackoff:    time.Duration(d.Get(client.RetryMinBackoffAttribute).(int)) * time.Second,
+		MaxBackoff:    time.Duration(d.Get(client.RetryMaxBackoffAttribute).(int)) * time.Second,
+		HostRateLimit: d.Get(client.HostRateLimitAttribute).(int),
+	}
+	for _, code := range d.Get(client.RetryableStatusCodesAttribute).([]any) {
+		policy.RetryableStatusCodes = append(policy.RetryableStatusCodes, code.(int))
+	}
+	for _, msg := range d.Get(client.RetryableErrorMessagesAttribute).([]any) {
+		policy.RetryableMessages = append(policy.RetryableMessages, msg.(string))
+	}
+	return client.ResolveRetryPolicy(policy)
+}
+
 func ConfigureDatabricksClient(ctx context.Context, d *schema.ResourceData, configCustomizer func(*config.Config) error) (any, diag.Diagnostics) {
 	cfg := &config.Config{}
 	attrsUsed := []string{}
@@ -337,10 +423,27 @@ func ConfigureDatabricksClient(ctx context.Context, d *schema.ResourceData, conf
 	} else {
 		tflog.Info(ctx, "(sdkv2) No attributes specified in provider configuration")
 	}
-	databricksClient, err := client.PrepareDatabricksClient(ctx, cfg, configCustomizer)
+	retryPolicy, err := retryPolicyFromProviderConfig(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	auditLog, err := client.ResolveAuditLog(d.Get(client.AuditLogPathAttribute).(string),
+		d.Get(client.AuditLogRequestBodiesAttribute).(bool), d.Get(client.AuditLogHmacKeyAttribute).(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	databricksClient, err := client.PrepareDatabricksClient(ctx, cfg, retryPolicy, auditLog, configCustomizer)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	databricksClient.SetSelfIP(client.ResolveSelfIP(d.Get(client.SelfIpAttribute).(string)))
+	bulkReadCache, err := client.ResolveBulkReadCache(d.Get(client.BulkReadCacheAttribute).(bool))
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
+	if bulkReadCache {
+		databricksClient.EnableBulkReadCache()
+	}
 	return databricksClient, nil
 }
 
@@ -2,7 +2,9 @@ package sdkv2
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -31,6 +33,61 @@ func TestConfigureDatabricksClient(t *testing.T) {
 				assert.Equal(t, 30, dc.Config.HTTPTimeoutSeconds, "HTTP timeout should be overridden when set")
 			},
 		},
+		{
+			name:   "Retry policy isn't configured by default",
+			config: map[string]interface{}{},
+			validateResourceData: func(dc *common.DatabricksClient) {
+				assert.False(t, dc.RetryPolicy().IsEnabled())
+				assert.Nil(t, dc.Config.HTTPTransport)
+			},
+		},
+		{
+			name: "Retry policy can be set in provider config",
+			config: map[string]interface{}{
+				"max_retries":               3,
+				"retry_min_backoff_seconds": 2,
+				"retry_max_backoff_seconds": 10,
+				"host_rate_limit":           5,
+				"retryable_status_codes":    []interface{}{409},
+				"retryable_error_messages":  []interface{}{"RESOURCE_CONFLICT"},
+			},
+			validateResourceData: func(dc *common.DatabricksClient) {
+				assert.Equal(t, common.RetryPolicy{
+					MaxRetries:           3,
+					MinBackoff:           2 * time.Second,
+					MaxBackoff:           10 * time.Second,
+					HostRateLimit:        5,
+					RetryableStatusCodes: []int{409},
+					RetryableMessages:    []string{"RESOURCE_CONFLICT"},
+				}, dc.RetryPolicy())
+				assert.NotNil(t, dc.Config.HTTPTransport)
+			},
+		},
+		{
+			name:   "Bulk read cache is disabled by default",
+			config: map[string]interface{}{},
+			validateResourceData: func(dc *common.DatabricksClient) {
+				assert.False(t, dc.BulkReadCacheEnabled())
+			},
+		},
+		{
+			name: "Bulk read cache can be enabled in provider config",
+			config: map[string]interface{}{
+				"bulk_read_cache": true,
+			},
+			validateResourceData: func(dc *common.DatabricksClient) {
+				assert.True(t, dc.BulkReadCacheEnabled())
+			},
+		},
+		{
+			name: "Audit log wraps HTTP transport",
+			config: map[string]interface{}{
+				"audit_log_path": filepath.Join(t.TempDir(), "audit.jsonl"),
+			},
+			validateResourceData: func(dc *common.DatabricksClient) {
+				assert.NotNil(t, dc.Config.HTTPTransport)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -54,3 +111,12 @@ func TestConfigureDatabricksClient(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigureDatabricksClient_InvalidRetryPolicy(t *testing.T) {
+	rd := schema.TestResourceDataRaw(t, DatabricksProvider().Schema, map[string]interface{}{
+		"retryable_status_codes": []interface{}{200},
+	})
+	_, diags := ConfigureDatabricksClient(context.Background(), rd, nil)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "retryable status code 200 must be between 400 and 599", diags[0].Summary)
+}
@@ -0,0 +1,140 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedProviderServer adds spans for operations of resources, data sources and actions of the
+// plugin framework provider. Resources of the SDKv2 provider get their spans from common.Resource.
+type tracedProviderServer struct {
+	tfprotov6.ProviderServer
+	tfprotov6.ActionServer
+	tfprotov6.ListResourceServer
+}
+
+func newTracedProviderServer(server tfprotov6.ProviderServer) tfprotov6.ProviderServer {
+	actions, ok := server.(tfprotov6.ProviderServerWithActions)
+	if !ok {
+		return server
+	}
+	lists, ok := server.(tfprotov6.ProviderServerWithListResource)
+	if !ok {
+		return server
+	}
+	return &tracedProviderServer{
+		ProviderServer:     server,
+		ActionServer:       actions,
+		ListResourceServer: lists,
+	}
+}
+
+func startOperationSpan(ctx context.Context, typeName, kind, operation string) (context.Context, trace.Span) {
+	return common.StartSpan(ctx, fmt.Sprintf("%s %s", typeName, operation),
+		attribute.String("databricks.resource.type", typeName),
+		attribute.String("databricks.resource.kind", kind),
+		attribute.String("databricks.operation", operation),
+		attribute.String("databricks.sdk", "pluginframework"))
+}
+
+// diagnosticsError returns the error diagnostics joined into a single error, if any
+func diagnosticsError(diags []*tfprotov6.Diagnostic) error {
+	var errs []string
+	for _, d := range diags {
+		if d != nil && d.Severity == tfprotov6.DiagnosticSeverityError {
+			errs = append(errs, d.Summary)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(errs, "; "))
+}
+
+// applyOperation returns the operation of the resource change: create if there's no prior state,
+// delete if there's no planned state, and update otherwise
+func applyOperation(req *tfprotov6.ApplyResourceChangeRequest) string {
+	if req.PriorState == nil {
+		return "create"
+	}
+	if isNull, err := req.PriorState.IsNull(); err == nil && isNull {
+		return "create"
+	}
+	if req.PlannedState == nil {
+		return "delete"
+	}
+	if isNull, err := req.PlannedState.IsNull(); err == nil && isNull {
+		return "delete"
+	}
+	return "update"
+}
+
+func (s *tracedProviderServer) ApplyResourceChange(ctx context.Context,
+	req *tfprotov6.ApplyResourceChangeRequest) (resp *tfprotov6.ApplyResourceChangeResponse, err error) {
+	ctx, span := startOperationSpan(ctx, req.TypeName, "resource", applyOperation(req))
+	defer func() {
+		if err == nil && resp != nil {
+			err = diagnosticsError(resp.Diagnostics)
+		}
+		common.EndSpan(span, err)
+	}()
+	return s.ProviderServer.ApplyResourceChange(ctx, req)
+}
+
+func (s *tracedProviderServer) ReadResource(ctx context.Context,
+	req *tfprotov6.ReadResourceRequest) (resp *tfprotov6.ReadResourceResponse, err error) {
+	ctx, span := startOperationSpan(ctx, req.TypeName, "resource", "read")
+	defer func() {
+		if err == nil && resp != nil {
+			err = diagnosticsError(resp.Diagnostics)
+		}
+		common.EndSpan(span, err)
+	}()
+	return s.ProviderServer.ReadResource(ctx, req)
+}
+
+func (s *tracedProviderServer) ReadDataSource(ctx context.Context,
+	req *tfprotov6.ReadDataSourceRequest) (resp *tfprotov6.ReadDataSourceResponse, err error) {
+	ctx, span := startOperationSpan(ctx, req.TypeName, "data", "read")
+	defer func() {
+		if err == nil && resp != nil {
+			err = diagnosticsError(resp.Diagnostics)
+		}
+		common.EndSpan(span, err)
+	}()
+	return s.ProviderServer.ReadDataSource(ctx, req)
+}
+
+// InvokeAction ends the span once all events of the action are streamed to Terraform
+func (s *tracedProviderServer) InvokeAction(ctx context.Context,
+	req *tfprotov6.InvokeActionRequest) (*tfprotov6.InvokeActionServerStream, error) {
+	ctx, span := startOperationSpan(ctx, req.ActionType, "action", "invoke")
+	stream, err := s.ActionServer.InvokeAction(ctx, req)
+	if err != nil || stream == nil || stream.Events == nil {
+		common.EndSpan(span, err)
+		return stream, err
+	}
+	events := stream.Events
+	stream.Events = func(yield func(tfprotov6.InvokeActionEvent) bool) {
+		var err error
+		defer func() {
+			common.EndSpan(span, err)
+		}()
+		for event := range events {
+			if completed, ok := event.Type.(tfprotov6.CompletedInvokeActionEventType); ok {
+				err = diagnosticsError(completed.Diagnostics)
+			}
+			if !yield(event) {
+				return
+			}
+		}
+	}
+	return stream, nil
+}
@@ -0,0 +1,67 @@
+package providers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func dynamicValue(t *testing.T, value tftypes.Value) *tfprotov6.DynamicValue {
+	dv, err := tfprotov6.NewDynamicValue(value.Type(), value)
+	require.NoError(t, err)
+	return &dv
+}
+
+func TestApplyOperation(t *testing.T) {
+	typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+	null := dynamicValue(t, tftypes.NewValue(typ, nil))
+	value := dynamicValue(t, tftypes.NewValue(typ, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "abc"),
+	}))
+	assert.Equal(t, "create", applyOperation(&tfprotov6.ApplyResourceChangeRequest{
+		PriorState:   null,
+		PlannedState: value,
+	}))
+	assert.Equal(t, "update", applyOperation(&tfprotov6.ApplyResourceChangeRequest{
+		PriorState:   value,
+		PlannedState: value,
+	}))
+	assert.Equal(t, "delete", applyOperation(&tfprotov6.ApplyResourceChangeRequest{
+		PriorState:   value,
+		PlannedState: null,
+	}))
+}
+
+func TestTracedProviderServer(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(previous)
+
+	server := newTracedProviderServer(providerserver.NewProtocol6(pluginfw.GetDatabricksProviderPluginFramework())())
+	_, ok := server.(tfprotov6.ProviderServerWithActions)
+	assert.True(t, ok, "actions must be available through the wrapper")
+	_, ok = server.(tfprotov6.ProviderServerWithListResource)
+	assert.True(t, ok, "list resources must be available through the wrapper")
+
+	_, err := server.ReadDataSource(context.Background(), &tfprotov6.ReadDataSourceRequest{
+		TypeName: "databricks_unknown",
+	})
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "databricks_unknown read", spans[0].Name())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, err.Error(), spans[0].Status().Description)
+}
@@ -335,6 +335,57 @@ func Read(jobID int64, w *databricks.WorkspaceClient, ctx context.Context) (job
 	return
 }
 
+const jobsBulkReadKind = "jobs"
+
+// readJob returns the job like [Read]. With the bulk read cache enabled, all jobs of the workspace are
+// listed once together with their tasks and shared by all databricks_job resources. The list doesn't
+// return the identity the job runs as, so runAs from the state is used instead. Jobs that have more tasks
+// or job clusters than the list returns are read individually.
+func readJob(ctx context.Context, c *common.DatabricksClient, w *databricks.WorkspaceClient,
+	jobID int64, runAs *jobs.JobRunAs) (*jobs.Job, error) {
+	if !c.BulkReadCacheEnabled() {
+		return Read(jobID, w, ctx)
+	}
+	all, err := common.BulkRead(ctx, c, jobsBulkReadKind, "",
+		func(ctx context.Context) (map[int64]jobs.BaseJob, error) {
+			list, err := w.Jobs.ListAll(ctx, jobs.ListJobsRequest{ExpandTasks: true})
+			if err != nil {
+				return nil, err
+			}
+			all := make(map[int64]jobs.BaseJob, len(list))
+			for _, job := range list {
+				all[job.JobId] = job
+			}
+			return all, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	base, ok := all[jobID]
+	if !ok {
+		return nil, &apierr.APIError{
+			ErrorCode:  "RESOURCE_DOES_NOT_EXIST",
+			StatusCode: 404,
+			Message:    fmt.Sprintf("Job %d does not exist.", jobID),
+		}
+	}
+	if base.HasMore || base.Settings == nil {
+		return Read(jobID, w, ctx)
+	}
+	settings := *base.Settings
+	js := JobSettingsResource{JobSettings: settings}
+	js.adjustTasks()
+	js.sortWebhooksByID()
+	settings.RunAs = runAs
+	return &jobs.Job{
+		CreatedTime:     base.CreatedTime,
+		CreatorUserName: base.CreatorUserName,
+		JobId:           base.JobId,
+		Settings:        &settings,
+		TriggerState:    base.TriggerState,
+	}, nil
+}
+
 func Start(jobID int64, timeout time.Duration, w *databricks.WorkspaceClient, ctx context.Context) error {
 	res, err := w.Jobs.RunNow(ctx, jobs.RunNow{
 		JobId: jobID,
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -580,7 +581,19 @@ func (JobSettingsResource) CustomizeSchema(s *common.CustomizableSchema) *common
 		Optional: true,
 		Default:  false,
 		Type:     schema.TypeBool,
-	})
+	}).AddNewField("settings_json", &schema.Schema{
+		Optional:         true,
+		Type:             schema.TypeString,
+		DiffSuppressFunc: suppressEquivalentJobSettings,
+		ConflictsWith:    []string{"settings_file"},
+	}).AddNewField("settings_file", &schema.Schema{
+		Optional:      true,
+		Type:          schema.TypeString,
+		ConflictsWith: []string{"settings_json"},
+	}).AddNewField("settings_md5", &schema.Schema{
+		Computed: true,
+		Type:     schema.TypeString,
+	}).AddDriftFields()
 
 	s.SchemaPath("always_running").SetConflictsWith([]string{"control_run_state", "continuous"})
 	s.SchemaPath("control_run_state").SetConflictsWith([]string{"always_running"})
@@ -655,6 +668,14 @@ func (JobSettingsResource) CustomizeSchema(s *common.CustomizableSchema) *common
 	// Technically this is required by the API, but marking it optional since we can infer it from the hostname.
 	s.SchemaPath("git_source", "provider").SetOptional()
 
+	// Job settings that aren't specified in the configuration come from `settings_json` or `settings_file`
+	for key, attr := range s.GetSchemaMap() {
+		if slices.Contains(jobSettingsSourceFields, key) {
+			continue
+		}
+		attr.DiffSuppressFunc = suppressIfDefinedBySettingsSource(key, attr.DiffSuppressFunc)
+	}
+
 	return s
 }
 
@@ -1058,7 +1079,7 @@ func ResourceJob() common.Resource {
 	getReadCtx := func(ctx context.Context, d *schema.ResourceData) context.Context {
 		var jsr JobSettingsResource
 		common.DataToStructPointer(d, jobsGoSdkSchema, &jsr)
-		if jsr.isMultiTask() {
+		if jsr.isMultiTask() || hasJobSettingsSource(d) {
 			return context.WithValue(ctx, common.Api, common.API_2_1)
 		}
 		return ctx
@@ -1066,6 +1087,7 @@ func ResourceJob() common.Resource {
 	return common.Resource{
 		Schema:        jobsGoSdkSchema,
 		SchemaVersion: 2,
+		Identity:      common.NewIdentity("job_id"),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(clusters.DefaultProvisionTimeout),
 			Update: schema.DefaultTimeout(clusters.DefaultProvisionTimeout),
@@ -1073,6 +1095,13 @@ func ResourceJob() common.Resource {
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
 			var jsr JobSettingsResource
 			common.DiffToStructPointer(d, jobsGoSdkSchema, &jsr)
+			if hasJobSettingsSource(d) {
+				settings, err := customizeDiffJobSettingsSource(d, jsr)
+				if err != nil {
+					return err
+				}
+				jsr = JobSettingsResource{JobSettings: settings}
+			}
 			alwaysRunning := d.Get("always_running").(bool)
 			if alwaysRunning && jsr.MaxConcurrentRuns > 1 {
 				return fmt.Errorf("`always_running` must be specified only with `max_concurrent_runs = 1`")
@@ -1089,8 +1118,30 @@ func ResourceJob() common.Resource {
 			return nil
 		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			defer c.InvalidateBulkRead(jobsBulkReadKind, "")
 			var jsr JobSettingsResource
 			common.DataToStructPointer(d, jobsGoSdkSchema, &jsr)
+			if hasJobSettingsSource(d) {
+				w, err := c.WorkspaceClient()
+				if err != nil {
+					return err
+				}
+				settings, md5Hash, err := jobSettingsFromSource(d, jsr)
+				if err != nil {
+					return err
+				}
+				var cj jobs.CreateJob
+				if err = convertJobSettings(settings, &cj); err != nil {
+					return err
+				}
+				jobId, err := Create(cj, w, ctx)
+				if err != nil {
+					return err
+				}
+				d.SetId(fmt.Sprintf("%d", jobId))
+				d.Set("settings_md5", md5Hash)
+				return getJobLifecycleManagerGoSdk(d, c).OnCreate(ctx)
+			}
 			if jsr.isMultiTask() {
 				// Api 2.1
 				w, err := c.WorkspaceClient()
@@ -1127,7 +1178,8 @@ func ResourceJob() common.Resource {
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var jsr JobSettingsResource
 			common.DataToStructPointer(d, jobsGoSdkSchema, &jsr)
-			if jsr.isMultiTask() {
+			drift := common.NewDrift(d, jobSettingsSourceFields...)
+			if jsr.isMultiTask() || hasJobSettingsSource(d) {
 				// Api 2.1
 				w, err := c.WorkspaceClient()
 				if err != nil {
@@ -1137,7 +1189,7 @@ func ResourceJob() common.Resource {
 				if err != nil {
 					return err
 				}
-				job, err := Read(jobID, w, ctx)
+				job, err := readJob(ctx, c, w, jobID, jsr.RunAs)
 				if err != nil {
 					return err
 				}
@@ -1146,7 +1198,14 @@ func ResourceJob() common.Resource {
 				res := JobSettingsResource{
 					JobSettings: *job.Settings,
 				}
-				return common.StructToData(res, jobsGoSdkSchema, d)
+				if hasJobSettingsSource(d) {
+					err = jobSettingsSourceToData(res, d)
+				} else {
+					err = common.StructToData(res, jobsGoSdkSchema, d)
+				}
+				if err != nil {
+					return err
+				}
 			} else {
 				// Api 2.0
 				// TODO: Deprecate and remove this code path
@@ -1155,12 +1214,43 @@ func ResourceJob() common.Resource {
 					return err
 				}
 				d.Set("url", c.FormatURL("#job/", d.Id()))
-				return common.StructToData(*job.Settings, jobsGoSdkSchema, d)
+				err = common.StructToData(*job.Settings, jobsGoSdkSchema, d)
+				if err != nil {
+					return err
+				}
 			}
+			// the Jobs API doesn't report who edited the job last
+			return drift.Check(ctx, d, nil)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			defer c.InvalidateBulkRead(jobsBulkReadKind, "")
 			var jsr JobSettingsResource
 			common.DataToStructPointer(d, jobsGoSdkSchema, &jsr)
+			common.ResetDrift(d)
+			if hasJobSettingsSource(d) {
+				settings, md5Hash, err := jobSettingsFromSource(d, jsr)
+				if err != nil {
+					return err
+				}
+				var js JobSettingsResource
+				if err = convertJobSettings(settings, &js.JobSettings); err != nil {
+					return err
+				}
+				jobID, err := parseJobId(d.Id())
+				if err != nil {
+					return err
+				}
+				w, err := c.WorkspaceClient()
+				if err != nil {
+					return err
+				}
+				err = Update(jobID, js, w, ctx)
+				if err != nil {
+					return err
+				}
+				d.Set("settings_md5", md5Hash)
+				return getJobLifecycleManagerGoSdk(d, c).OnUpdate(ctx)
+			}
 			if jsr.isMultiTask() {
 				// Api 2.1
 				err := prepareJobSettingsForUpdateGoSdk(d, &jsr)
@@ -1197,6 +1287,7 @@ func ResourceJob() common.Resource {
 			}
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			defer c.InvalidateBulkRead(jobsBulkReadKind, "")
 			ctx = getReadCtx(ctx, d)
 			w, err := c.WorkspaceClient()
 			if err != nil {
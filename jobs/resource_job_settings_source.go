@@ -0,0 +1,384 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"slices"
+
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// attributes of databricks_job that aren't job settings, so they can't override the settings
+// coming from `settings_json` or `settings_file`
+var jobSettingsSourceFields = []string{
+	"settings_json",
+	"settings_file",
+	"settings_md5",
+	"url",
+	"always_running",
+	"control_run_state",
+	"on_drift",
+	"settings_hash",
+}
+
+// keys that identify items of lists in job settings, i.e. tasks or job clusters
+var jobSettingsListItemKeys = []string{"task_key", "job_cluster_key", "environment_key"}
+
+type rawConfigGetter interface {
+	Get(key string) any
+	GetRawConfig() cty.Value
+}
+
+// hasJobSettingsSource returns true if job settings are defined by `settings_json` or `settings_file`
+func hasJobSettingsSource(d rawConfigGetter) bool {
+	raw := d.GetRawConfig()
+	if !raw.IsNull() && raw.IsKnown() {
+		for _, key := range []string{"settings_json", "settings_file"} {
+			v := raw.GetAttr(key)
+			if v.IsNull() {
+				continue
+			}
+			if !v.IsKnown() || v.AsString() != "" {
+				return true
+			}
+		}
+		return false
+	}
+	return d.Get("settings_json").(string) != "" || d.Get("settings_file").(string) != ""
+}
+
+// suppressIfDefinedBySettingsSource suppresses diffs of attributes that aren't specified in
+// the configuration, when job settings come from `settings_json` or `settings_file`
+func suppressIfDefinedBySettingsSource(key string, suppress schema.SchemaDiffSuppressFunc) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if hasJobSettingsSource(d) && !isConfiguredJobAttribute(d.GetRawConfig(), key) {
+			return true
+		}
+		if suppress == nil {
+			return false
+		}
+		return suppress(k, old, new, d)
+	}
+}
+
+// suppressEquivalentJobSettings suppresses diffs of `settings_json` that differ only in formatting
+func suppressEquivalentJobSettings(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+	_, oldMd5, err := parseJobSettings(old)
+	if err != nil {
+		return false
+	}
+	_, newMd5, err := parseJobSettings(new)
+	if err != nil {
+		return false
+	}
+	return oldMd5 == newMd5
+}
+
+func isConfiguredJobAttribute(raw cty.Value, key string) bool {
+	if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute(key) {
+		return false
+	}
+	v := raw.GetAttr(key)
+	if v.IsNull() {
+		return false
+	}
+	if v.IsKnown() && (v.Type().IsListType() || v.Type().IsSetType()) && v.LengthInt() == 0 {
+		return false
+	}
+	return true
+}
+
+// jobSettingsOverrides returns Jobs API names of top-level settings that are specified in
+// the configuration and take precedence over `settings_json` or `settings_file`
+func jobSettingsOverrides(raw cty.Value) []string {
+	overrides := []string{}
+	if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() {
+		return overrides
+	}
+	for key := range raw.Type().AttributeTypes() {
+		if slices.Contains(jobSettingsSourceFields, key) || !isConfiguredJobAttribute(raw, key) {
+			continue
+		}
+		apiName := key
+		for name, alias := range jobSpecAliases {
+			if alias == key {
+				apiName = name
+			}
+		}
+		overrides = append(overrides, apiName)
+	}
+	slices.Sort(overrides)
+	return overrides
+}
+
+// parseJobSettings reads Jobs API JSON or YAML and returns job settings normalized by a round trip
+// through the Jobs API types, together with MD5 hash of the normalized JSON. Payloads of `jobs/get`
+// and `jobs/reset` are unwrapped, as well as bundle configuration with a single job.
+func parseJobSettings(content string) (map[string]any, string, error) {
+	var doc map[string]any
+	err := json.Unmarshal([]byte(content), &doc)
+	if err != nil {
+		var yamlDoc map[string]any
+		if yamlErr := yaml.Unmarshal([]byte(content), &yamlDoc); yamlErr != nil {
+			return nil, "", fmt.Errorf("job settings are neither valid JSON nor YAML: %w", err)
+		}
+		// YAML values are converted to the same types as values of JSON documents
+		raw, err := json.Marshal(yamlDoc)
+		if err != nil {
+			return nil, "", fmt.Errorf("cannot convert YAML job settings: %w", err)
+		}
+		if err = json.Unmarshal(raw, &doc); err != nil {
+			return nil, "", fmt.Errorf("cannot convert YAML job settings: %w", err)
+		}
+	}
+	if resources, ok := doc["resources"].(map[string]any); ok {
+		bundleJobs, _ := resources["jobs"].(map[string]any)
+		if len(bundleJobs) != 1 {
+			return nil, "", fmt.Errorf("bundle configuration should have exactly one job, but has %d", len(bundleJobs))
+		}
+		for _, job := range bundleJobs {
+			doc, _ = job.(map[string]any)
+		}
+	}
+	for _, wrapper := range []string{"settings", "new_settings"} {
+		if inner, ok := doc[wrapper].(map[string]any); ok {
+			doc = inner
+			break
+		}
+	}
+	if len(doc) == 0 {
+		return nil, "", fmt.Errorf("job settings are empty")
+	}
+	var settings jobs.JobSettings
+	if err = convertJobSettings(doc, &settings); err != nil {
+		return nil, "", err
+	}
+	normalized := map[string]any{}
+	if err = convertJobSettings(settings, &normalized); err != nil {
+		return nil, "", err
+	}
+	raw, err := json.Marshal(normalized)
+	if err != nil {
+		return nil, "", err
+	}
+	return normalized, common.CalculateMd5Hash(raw), nil
+}
+
+// readJobSettingsSource reads job settings either from `settings_json` or from `settings_file`
+func readJobSettingsSource(d rawConfigGetter) (map[string]any, string, error) {
+	content, _, err := common.ReadSerializedJsonContent(d.Get("settings_json").(string), d.Get("settings_file").(string))
+	if err != nil {
+		return nil, "", err
+	}
+	return parseJobSettings(content)
+}
+
+// convertJobSettings converts between maps and Jobs API types through JSON
+func convertJobSettings(from, to any) error {
+	raw, err := json.Marshal(from)
+	if err != nil {
+		return fmt.Errorf("invalid job settings: %w", err)
+	}
+	if err = json.Unmarshal(raw, to); err != nil {
+		return fmt.Errorf("invalid job settings: %w", err)
+	}
+	return nil
+}
+
+// mergeJobSettings replaces top-level settings from the document with the ones from the configuration
+func mergeJobSettings(doc map[string]any, jsr JobSettingsResource, overrides []string) (map[string]any, error) {
+	merged := map[string]any{}
+	for k, v := range doc {
+		merged[k] = v
+	}
+	if len(overrides) == 0 {
+		return merged, nil
+	}
+	configured := map[string]any{}
+	if err := convertJobSettings(jsr.JobSettings, &configured); err != nil {
+		return nil, err
+	}
+	for _, key := range overrides {
+		if v, ok := configured[key]; ok {
+			merged[key] = v
+		} else {
+			delete(merged, key)
+		}
+	}
+	return merged, nil
+}
+
+// jobSettingsMatch returns true if every value of the expected settings has the same value in the
+// actual ones. Missing values match zero values, and items of lists like tasks or job clusters are
+// matched by their keys.
+func jobSettingsMatch(expected, actual any) bool {
+	switch e := expected.(type) {
+	case map[string]any:
+		a, _ := actual.(map[string]any)
+		for k, v := range e {
+			if !jobSettingsMatch(v, a[k]) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		a, _ := actual.([]any)
+		if len(e) != len(a) {
+			return false
+		}
+		if key := jobSettingsListItemKey(e, a); key != "" {
+			actualItems := map[any]any{}
+			for _, item := range a {
+				actualItems[item.(map[string]any)[key]] = item
+			}
+			for _, item := range e {
+				if !jobSettingsMatch(item, actualItems[item.(map[string]any)[key]]) {
+					return false
+				}
+			}
+			return true
+		}
+		for i := range e {
+			if !jobSettingsMatch(e[i], a[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		if actual == nil {
+			return expected == nil || reflect.ValueOf(expected).IsZero()
+		}
+		return reflect.DeepEqual(expected, actual)
+	}
+}
+
+// jobSettingsListItemKey returns the key that identifies items of both lists, or an empty string
+func jobSettingsListItemKey(lists ...[]any) string {
+	for _, key := range jobSettingsListItemKeys {
+		found := true
+		for _, list := range lists {
+			for _, item := range list {
+				m, ok := item.(map[string]any)
+				if !ok || m[key] == nil {
+					found = false
+				}
+			}
+		}
+		if found {
+			return key
+		}
+	}
+	return ""
+}
+
+// customizeDiffJobSettingsSource plans an update when the content of `settings_json` or `settings_file`
+// changes, or when the job was changed outside of Terraform. Returns merged job settings.
+func customizeDiffJobSettingsSource(d *schema.ResourceDiff, jsr JobSettingsResource) (jobs.JobSettings, error) {
+	var settings jobs.JobSettings
+	if !d.NewValueKnown("settings_json") || !d.NewValueKnown("settings_file") {
+		return jsr.JobSettings, d.SetNewComputed("settings_md5")
+	}
+	doc, md5Hash, err := readJobSettingsSource(d)
+	if err != nil {
+		return settings, err
+	}
+	overrides := jobSettingsOverrides(d.GetRawConfig())
+	merged, err := mergeJobSettings(doc, jsr, overrides)
+	if err != nil {
+		return settings, err
+	}
+	if err = convertJobSettings(merged, &settings); err != nil {
+		return settings, err
+	}
+	if d.Get("settings_md5").(string) != md5Hash {
+		return settings, d.SetNew("settings_md5", md5Hash)
+	}
+	// settings that aren't overridden are kept in the state as they were read from the job
+	var state JobSettingsResource
+	if err = jobSettingsFromState(d, &state); err != nil {
+		return settings, err
+	}
+	current := map[string]any{}
+	if err = convertJobSettings(state.JobSettings, &current); err != nil {
+		return settings, err
+	}
+	expected := map[string]any{}
+	for k, v := range doc {
+		if !slices.Contains(overrides, k) {
+			expected[k] = v
+		}
+	}
+	if !jobSettingsMatch(expected, current) {
+		log.Printf("[INFO] Job %s was changed outside of `settings_json` or `settings_file`", d.Id())
+		return settings, d.SetNewComputed("settings_md5")
+	}
+	return settings, nil
+}
+
+// jobSettingsFromSource returns job settings from `settings_json` or `settings_file` merged with
+// settings specified in the configuration, and MD5 hash of the settings document
+func jobSettingsFromSource(d *schema.ResourceData, jsr JobSettingsResource) (map[string]any, string, error) {
+	doc, md5Hash, err := readJobSettingsSource(d)
+	if err != nil {
+		return nil, "", err
+	}
+	overrides := jobSettingsOverrides(d.GetRawConfig())
+	if slices.Contains(overrides, "job_clusters") {
+		for i := range jsr.JobClusters {
+			err = updateJobClusterSpec(d, fmt.Sprintf("job_cluster.%d.new_cluster.0", i), &jsr.JobClusters[i].NewCluster)
+			if err != nil {
+				return nil, "", err
+			}
+		}
+	}
+	merged, err := mergeJobSettings(doc, jsr, overrides)
+	if err != nil {
+		return nil, "", err
+	}
+	return merged, md5Hash, nil
+}
+
+// jobSettingsSourceToData sets all settings read from the job, including the ones that aren't yet in
+// the state, because they come from `settings_json` or `settings_file` and aren't in the configuration
+func jobSettingsSourceToData(res JobSettingsResource, d *schema.ResourceData) error {
+	read := (&schema.Resource{Schema: jobsGoSdkSchema}).Data(nil)
+	read.MarkNewResource()
+	if err := common.StructToData(res, jobsGoSdkSchema, read); err != nil {
+		return err
+	}
+	for key := range jobsGoSdkSchema {
+		if slices.Contains(jobSettingsSourceFields, key) {
+			continue
+		}
+		if err := d.Set(key, read.Get(key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jobSettingsFromState reads job settings from the prior state, without defaults of attributes
+// that aren't in the configuration
+func jobSettingsFromState(d *schema.ResourceDiff, state *JobSettingsResource) error {
+	prior := (&schema.Resource{Schema: jobsGoSdkSchema}).Data(nil)
+	for key := range jobsGoSdkSchema {
+		if slices.Contains(jobSettingsSourceFields, key) {
+			continue
+		}
+		old, _ := d.GetChange(key)
+		if err := prior.Set(key, old); err != nil {
+			return err
+		}
+	}
+	common.DataToStructPointer(prior, jobsGoSdkSchema, state)
+	return nil
+}
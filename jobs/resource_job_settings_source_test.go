@@ -0,0 +1,322 @@
+package jobs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testJobSettingsJson = `{
+  "name": "Featurizer",
+  "tags": {"team": "data"},
+  "tasks": [
+    {
+      "task_key": "b",
+      "depends_on": [{"task_key": "a"}],
+      "notebook_task": {"notebook_path": "/Stuff"},
+      "existing_cluster_id": "abc"
+    },
+    {
+      "task_key": "a",
+      "notebook_task": {"notebook_path": "/Prepare"},
+      "existing_cluster_id": "abc"
+    }
+  ],
+  "timeout_seconds": 0
+}`
+
+const testJobSettingsYaml = `
+resources:
+  jobs:
+    featurizer:
+      name: Featurizer
+      tags:
+        team: data
+      tasks:
+        - task_key: b
+          depends_on:
+            - task_key: a
+          notebook_task:
+            notebook_path: /Stuff
+          existing_cluster_id: abc
+        - task_key: a
+          notebook_task:
+            notebook_path: /Prepare
+          existing_cluster_id: abc
+      timeout_seconds: 0
+`
+
+var testJobSettingsTasks = []jobs.Task{
+	{
+		TaskKey: "a",
+		NotebookTask: &jobs.NotebookTask{
+			NotebookPath: "/Prepare",
+		},
+		ExistingClusterId: "abc",
+	},
+	{
+		TaskKey: "b",
+		DependsOn: []jobs.TaskDependency{
+			{TaskKey: "a"},
+		},
+		NotebookTask: &jobs.NotebookTask{
+			NotebookPath: "/Stuff",
+		},
+		ExistingClusterId: "abc",
+	},
+}
+
+func TestParseJobSettings(t *testing.T) {
+	fromJson, jsonMd5, err := parseJobSettings(testJobSettingsJson)
+	require.NoError(t, err)
+	fromYaml, yamlMd5, err := parseJobSettings(testJobSettingsYaml)
+	require.NoError(t, err)
+	assert.Equal(t, fromJson, fromYaml)
+	assert.Equal(t, jsonMd5, yamlMd5)
+	assert.Equal(t, "Featurizer", fromJson["name"])
+	assert.Equal(t, float64(0), fromJson["timeout_seconds"])
+
+	wrapped, wrappedMd5, err := parseJobSettings(`{"job_id": 123, "settings": ` + testJobSettingsJson + `}`)
+	require.NoError(t, err)
+	assert.Equal(t, fromJson, wrapped)
+	assert.Equal(t, jsonMd5, wrappedMd5)
+
+	_, err = parseJobSettingsErr(`resources: {jobs: {a: {name: a}, b: {name: b}}}`)
+	assert.EqualError(t, err, "bundle configuration should have exactly one job, but has 2")
+	_, err = parseJobSettingsErr(`{}`)
+	assert.EqualError(t, err, "job settings are empty")
+	_, err = parseJobSettingsErr(`{"name": `)
+	assert.ErrorContains(t, err, "job settings are neither valid JSON nor YAML")
+}
+
+func parseJobSettingsErr(content string) (map[string]any, error) {
+	doc, _, err := parseJobSettings(content)
+	return doc, err
+}
+
+func TestJobSettingsOverrides(t *testing.T) {
+	raw := cty.ObjectVal(map[string]cty.Value{
+		"name":          cty.NullVal(cty.String),
+		"settings_json": cty.StringVal("{}"),
+		"tags":          cty.MapVal(map[string]cty.Value{"team": cty.StringVal("ml")}),
+		"task":          cty.ListValEmpty(cty.String),
+		"job_cluster":   cty.ListVal([]cty.Value{cty.StringVal("x")}),
+	})
+	assert.Equal(t, []string{"job_clusters", "tags"}, jobSettingsOverrides(raw))
+	assert.Equal(t, []string{}, jobSettingsOverrides(cty.NullVal(raw.Type())))
+}
+
+func TestMergeJobSettings(t *testing.T) {
+	doc, _, err := parseJobSettings(testJobSettingsJson)
+	require.NoError(t, err)
+	merged, err := mergeJobSettings(doc, JobSettingsResource{
+		JobSettings: jobs.JobSettings{
+			Name: "Ignored",
+			Tags: map[string]string{"team": "ml"},
+		},
+	}, []string{"tags", "timeout_seconds"})
+	require.NoError(t, err)
+	assert.Equal(t, "Featurizer", merged["name"])
+	assert.Equal(t, map[string]any{"team": "ml"}, merged["tags"])
+	assert.NotContains(t, merged, "timeout_seconds")
+	assert.Equal(t, map[string]any{"team": "data"}, doc["tags"])
+}
+
+func TestJobSettingsMatch(t *testing.T) {
+	expected, _, err := parseJobSettings(testJobSettingsJson)
+	require.NoError(t, err)
+	actual := map[string]any{}
+	err = convertJobSettings(jobs.JobSettings{
+		Name:              "Featurizer",
+		Tags:              map[string]string{"team": "data"},
+		Tasks:             testJobSettingsTasks,
+		MaxConcurrentRuns: 1,
+		Format:            jobs.FormatMultiTask,
+	}, &actual)
+	require.NoError(t, err)
+	assert.True(t, jobSettingsMatch(expected, actual))
+
+	actual["tags"] = map[string]any{"team": "ml"}
+	assert.False(t, jobSettingsMatch(expected, actual))
+
+	actual["tags"] = map[string]any{"team": "data"}
+	actual["tasks"] = actual["tasks"].([]any)[:1]
+	assert.False(t, jobSettingsMatch(expected, actual))
+}
+
+func TestResourceJobCreate_SettingsJson(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.2/jobs/create",
+				ExpectedRequest: jobs.CreateJob{
+					Name:  "Featurizer",
+					Tags:  map[string]string{"team": "data"},
+					Tasks: testJobSettingsTasks,
+					Queue: &jobs.QueueSettings{
+						Enabled: false,
+					},
+					TimeoutSeconds:  0,
+					ForceSendFields: []string{"TimeoutSeconds"},
+				},
+				Response: jobs.CreateResponse{
+					JobId: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.2/jobs/get?job_id=789",
+				Response: jobs.Job{
+					JobId: 789,
+					Settings: &jobs.JobSettings{
+						Name:              "Featurizer",
+						Tags:              map[string]string{"team": "data"},
+						Tasks:             testJobSettingsTasks,
+						MaxConcurrentRuns: 1,
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `settings_json = <<EOT
+` + testJobSettingsJson + `
+EOT
+`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "789", d.Id())
+	_, md5Hash, err := parseJobSettings(testJobSettingsJson)
+	require.NoError(t, err)
+	assert.Equal(t, md5Hash, d.Get("settings_md5"))
+	assert.Equal(t, "Featurizer", d.Get("name"))
+	assert.Equal(t, 2, d.Get("task.#"))
+}
+
+func TestResourceJobUpdate_SettingsFile(t *testing.T) {
+	settingsFile := filepath.Join(t.TempDir(), "job.yml")
+	err := os.WriteFile(settingsFile, []byte(testJobSettingsYaml), 0644)
+	require.NoError(t, err)
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.2/jobs/reset",
+				ExpectedRequest: jobs.ResetJob{
+					JobId: 789,
+					NewSettings: jobs.JobSettings{
+						Name: "Featurizer",
+						Tags: map[string]string{"team": "data"},
+						// tasks are sent in the order of the settings file
+						Tasks: []jobs.Task{testJobSettingsTasks[1], testJobSettingsTasks[0]},
+						Queue: &jobs.QueueSettings{
+							Enabled: false,
+						},
+						TimeoutSeconds:  0,
+						ForceSendFields: []string{"TimeoutSeconds"},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.2/jobs/get?job_id=789",
+				Response: jobs.Job{
+					JobId: 789,
+					Settings: &jobs.JobSettings{
+						Name:              "Featurizer",
+						Tags:              map[string]string{"team": "data"},
+						Tasks:             testJobSettingsTasks,
+						MaxConcurrentRuns: 1,
+					},
+				},
+			},
+		},
+		ID:     "789",
+		Update: true,
+		InstanceState: map[string]string{
+			"settings_file": settingsFile,
+			"settings_md5":  "outdated",
+			"name":          "Featurizer",
+		},
+		Resource: ResourceJob(),
+		HCL:      `settings_file = "` + settingsFile + `"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"name":   "Featurizer",
+		"task.#": 2,
+	})
+}
+
+func TestResourceJobSettingsJson_NoDrift(t *testing.T) {
+	_, md5Hash, err := parseJobSettings(testJobSettingsJson)
+	require.NoError(t, err)
+	qa.ResourceFixture{
+		Resource: ResourceJob(),
+		ID:       "789",
+		InstanceState: map[string]string{
+			"settings_json":                        testJobSettingsJson,
+			"always_running":                       "false",
+			"control_run_state":                    "false",
+			"url":                                  "https://localhost/#job/789",
+			"settings_md5":                         md5Hash,
+			"settings_hash":                        "abc",
+			"name":                                 "Featurizer",
+			"max_concurrent_runs":                  "1",
+			"tags.%":                               "1",
+			"tags.team":                            "data",
+			"task.#":                               "2",
+			"task.0.task_key":                      "a",
+			"task.0.existing_cluster_id":           "abc",
+			"task.0.notebook_task.#":               "1",
+			"task.0.notebook_task.0.notebook_path": "/Prepare",
+			"task.1.task_key":                      "b",
+			"task.1.existing_cluster_id":           "abc",
+			"task.1.depends_on.#":                  "1",
+			"task.1.depends_on.0.task_key":         "a",
+			"task.1.notebook_task.#":               "1",
+			"task.1.notebook_task.0.notebook_path": "/Stuff",
+		},
+		HCL: `settings_json = <<EOT
+` + testJobSettingsJson + `
+EOT
+`,
+		ExpectedDiff: map[string]*terraform.ResourceAttrDiff{},
+	}.ApplyNoError(t)
+}
+
+func TestResourceJobSettingsJson_Drift(t *testing.T) {
+	_, md5Hash, err := parseJobSettings(testJobSettingsJson)
+	require.NoError(t, err)
+	qa.ResourceFixture{
+		Resource: ResourceJob(),
+		ID:       "789",
+		InstanceState: map[string]string{
+			"settings_json":       testJobSettingsJson,
+			"always_running":      "false",
+			"control_run_state":   "false",
+			"url":                 "https://localhost/#job/789",
+			"settings_md5":        md5Hash,
+			"settings_hash":       "abc",
+			"name":                "Featurizer",
+			"max_concurrent_runs": "1",
+			"tags.%":              "1",
+			"tags.team":           "changed-in-ui",
+			"task.#":              "1",
+			"task.0.task_key":     "a",
+		},
+		HCL: `settings_json = <<EOT
+` + testJobSettingsJson + `
+EOT
+`,
+		ExpectedDiff: map[string]*terraform.ResourceAttrDiff{
+			"settings_md5": {Old: md5Hash, New: "", NewComputed: true},
+		},
+	}.ApplyNoError(t)
+}
@@ -2349,6 +2349,50 @@ func TestResourceJobRead(t *testing.T) {
 	assert.Equal(t, "abc", d.Get("existing_cluster_id"))
 }
 
+func TestResourceJobRead_DriftFail(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.2/jobs/get?job_id=789",
+				Response: jobs.Job{
+					JobId:           789,
+					CreatorUserName: "creator@example.com",
+					Settings: &jobs.JobSettings{
+						Name:              "Changed in UI",
+						MaxConcurrentRuns: 1,
+						Tasks: []jobs.Task{
+							{
+								TaskKey:           "a",
+								ExistingClusterId: "abc",
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceJob(),
+		Read:     true,
+		ID:       "789",
+		InstanceState: map[string]string{
+			"name":                       "Featurizer",
+			"max_concurrent_runs":        "1",
+			"task.#":                     "1",
+			"task.0.task_key":            "a",
+			"task.0.existing_cluster_id": "abc",
+			"on_drift":                   "fail",
+			"settings_hash":              "applied-by-terraform",
+		},
+		HCL: `
+		name = "Featurizer"
+		on_drift = "fail"
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+		}`,
+	}.ExpectError(t, "resource 789 was changed outside of Terraform: changed: name")
+}
+
 func TestResourceJobRead_NotFound(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -3606,3 +3650,96 @@ func TestJobResource_SparkConfDiffSuppress(t *testing.T) {
 	assert.True(t, scs.DiffSuppressFunc("new_cluster.0.spark_conf.%", "1", "0", nil))
 	assert.False(t, scs.DiffSuppressFunc("new_cluster.0.spark_conf.%", "1", "1", nil))
 }
+
+func TestResourceJobRead_BulkReadCache(t *testing.T) {
+	task := jobs.Task{
+		TaskKey:      "a",
+		NotebookTask: &jobs.NotebookTask{NotebookPath: "/Stuff"},
+	}
+	client, server, err := qa.HttpFixtureClient(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.2/jobs/list?expand_tasks=true",
+			Response: jobs.ListJobsResponse{
+				Jobs: []jobs.BaseJob{
+					{
+						JobId: 789,
+						Settings: &jobs.JobSettings{
+							Name:  "Listed",
+							Tasks: []jobs.Task{task},
+						},
+					},
+					{
+						JobId:   790,
+						HasMore: true,
+						Settings: &jobs.JobSettings{
+							Name:  "Truncated",
+							Tasks: []jobs.Task{task},
+						},
+					},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.2/jobs/get?job_id=790",
+			Response: jobs.Job{
+				JobId:         790,
+				RunAsUserName: "abc@example.com",
+				Settings: &jobs.JobSettings{
+					Name:  "Read individually",
+					Tasks: []jobs.Task{task},
+				},
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.2/jobs/delete",
+			ExpectedRequest: jobs.DeleteJob{
+				JobId: 789,
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.2/jobs/list?expand_tasks=true",
+			Response: jobs.ListJobsResponse{},
+		},
+	})
+	require.NoError(t, err)
+	defer server.Close()
+	client.EnableBulkReadCache()
+	ctx := context.Background()
+	r := ResourceJob().ToResource()
+	read := func(id string, runAs map[string]any) map[string]any {
+		d := r.TestResourceData()
+		d.SetId(id)
+		d.Set("format", "MULTI_TASK")
+		d.Set("name", "Old name")
+		if runAs != nil {
+			d.Set("run_as", []any{runAs})
+		}
+		diags := r.ReadContext(ctx, d, client)
+		require.False(t, diags.HasError(), "%v", diags)
+		return map[string]any{
+			"id":      d.Id(),
+			"name":    d.Get("name"),
+			"user":    d.Get("run_as.0.user_name"),
+			"service": d.Get("run_as.0.service_principal_name"),
+		}
+	}
+	// the listed job keeps run_as from the state, because the list doesn't return it
+	assert.Equal(t, map[string]any{"id": "789", "name": "Listed",
+		"user": "abc@example.com", "service": ""},
+		read("789", map[string]any{"user_name": "abc@example.com"}))
+	// the job with truncated tasks is read individually
+	assert.Equal(t, map[string]any{"id": "790", "name": "Read individually",
+		"user": "abc@example.com", "service": ""}, read("790", nil))
+
+	d := r.TestResourceData()
+	d.SetId("789")
+	diags := r.DeleteContext(ctx, d, client)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	// delete invalidates the cached list of jobs
+	assert.Equal(t, "", read("789", nil)["id"])
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/exporter"
@@ -38,6 +39,11 @@ func main() {
 	log.Printf(startMessageFormat, common.Version())
 
 	ctx := context.Background()
+	// spans are exported only if an OTLP endpoint is configured with OTEL_* environment variables
+	shutdownTracing, err := common.SetupTracing(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
 	providerServer, err := providers.GetProviderServer(ctx)
 	if err != nil {
 		log.Fatal(err)
@@ -53,6 +59,18 @@ func main() {
 		func() tfprotov6.ProviderServer { return providerServer },
 		serveOpts...,
 	)
+	// idle execution contexts are destroyed here, if Terraform stops the provider gracefully,
+	// otherwise clusters remove them on their own
+	cleanupCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	common.CloseCommandContexts(cleanupCtx)
+	cancel()
+	// spans are exported in batches in the background, the last batch is exported here, when
+	// Terraform stops the provider
+	cleanupCtx, cancel = context.WithTimeout(ctx, 2*time.Second)
+	if err := shutdownTracing(cleanupCtx); err != nil {
+		log.Printf("[WARN] Cannot export remaining spans: %s", err)
+	}
+	cancel()
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,45 @@
+package mws
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/provisioning"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+func DataSourceMwsCustomerManagedKey() common.Resource {
+	type mwsCustomerManagedKey struct {
+		provisioning.CustomerManagedKey
+	}
+
+	type mwsCustomerManagedKeyParams struct {
+		CustomerManagedKeyId string `json:"customer_managed_key_id" tf:"computed,optional"`
+		KeyAlias             string `json:"key_alias" tf:"optional"`
+		KeyId                string `json:"key_id" tf:"optional"`
+		Region               string `json:"region" tf:"optional"`
+		UseCase              string `json:"use_case" tf:"optional"`
+	}
+
+	return common.AccountDataWithParams(func(ctx context.Context, data mwsCustomerManagedKeyParams, a *databricks.AccountClient) (*mwsCustomerManagedKey, error) {
+		list, err := a.EncryptionKeys.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		key, err := findSingle("customer-managed key", list, func(k provisioning.CustomerManagedKey) bool {
+			keyAlias := ""
+			if k.AwsKeyInfo != nil {
+				keyAlias = k.AwsKeyInfo.KeyAlias
+			}
+			return matchesFilter(data.CustomerManagedKeyId, k.CustomerManagedKeyId) &&
+				matchesFilter(data.KeyAlias, keyAlias) &&
+				matchesFilter(data.KeyId, keyIdentifier(k)) &&
+				matchesFilter(data.Region, keyRegion(k)) &&
+				matchesFilter(data.UseCase, keyUseCases(k)...)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &mwsCustomerManagedKey{CustomerManagedKey: *key}, nil
+	})
+}
@@ -0,0 +1,108 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/provisioning"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func getTestCustomerManagedKeys() []provisioning.CustomerManagedKey {
+	return []provisioning.CustomerManagedKey{
+		{
+			CustomerManagedKeyId: "cmk1",
+			AwsKeyInfo: &provisioning.AwsKeyInfo{
+				KeyArn:    "arn:aws:kms:us-east-1:123:key/k1",
+				KeyAlias:  "alias/databricks",
+				KeyRegion: "us-east-1",
+			},
+			UseCases: []provisioning.KeyUseCase{provisioning.KeyUseCaseManagedServices},
+		},
+		{
+			CustomerManagedKeyId: "cmk2",
+			AwsKeyInfo: &provisioning.AwsKeyInfo{
+				KeyArn:    "arn:aws:kms:us-east-1:123:key/k2",
+				KeyRegion: "us-east-1",
+			},
+			UseCases: []provisioning.KeyUseCase{provisioning.KeyUseCaseStorage},
+		},
+		{
+			CustomerManagedKeyId: "cmk3",
+			GcpKeyInfo: &provisioning.GcpKeyInfo{
+				KmsKeyId: "projects/p/locations/europe-west1/keyRings/r/cryptoKeys/k3",
+			},
+			UseCases: []provisioning.KeyUseCase{
+				provisioning.KeyUseCaseManagedServices,
+				provisioning.KeyUseCaseStorage,
+			},
+		},
+	}
+}
+
+func TestDataSourceMwsCustomerManagedKey(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockEncryptionKeysAPI().EXPECT().List(mock.Anything).Return(getTestCustomerManagedKeys(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsCustomerManagedKey(),
+		ID:          "_",
+		HCL: `
+		region = "us-east-1"
+		use_case = "STORAGE"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"customer_managed_key_id": "cmk2",
+		"aws_key_info.0.key_arn":  "arn:aws:kms:us-east-1:123:key/k2",
+		"use_cases":               []any{"STORAGE"},
+	})
+}
+
+func TestDataSourceMwsCustomerManagedKey_Gcp(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockEncryptionKeysAPI().EXPECT().List(mock.Anything).Return(getTestCustomerManagedKeys(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsCustomerManagedKey(),
+		ID:          "_",
+		HCL: `
+		region = "europe-west1"
+		use_case = "STORAGE"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"customer_managed_key_id":   "cmk3",
+		"gcp_key_info.0.kms_key_id": "projects/p/locations/europe-west1/keyRings/r/cryptoKeys/k3",
+	})
+}
+
+func TestDataSourceMwsCustomerManagedKey_Ambiguous(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockEncryptionKeysAPI().EXPECT().List(mock.Anything).Return(getTestCustomerManagedKeys(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsCustomerManagedKey(),
+		ID:          "_",
+		HCL:         `use_case = "MANAGED_SERVICES"`,
+	}.ExpectError(t, "2 objects of type customer-managed key match the given filters, use more specific filters")
+}
+
+func TestDataSourceMwsCustomerManagedKey_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		AccountID:   "abc",
+		Resource:    DataSourceMwsCustomerManagedKey(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `key_alias = "alias/databricks"`,
+	}.ExpectError(t, "i'm a teapot")
+}
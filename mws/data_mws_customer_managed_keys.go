@@ -0,0 +1,36 @@
+package mws
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+func DataSourceMwsCustomerManagedKeys() common.Resource {
+	type mwsCustomerManagedKeys struct {
+		Ids map[string]string `json:"ids,omitempty" tf:"computed"`
+	}
+
+	type mwsCustomerManagedKeysParams struct {
+		Region  string `json:"region" tf:"optional"`
+		UseCase string `json:"use_case" tf:"optional"`
+	}
+
+	return common.AccountDataWithParams(func(ctx context.Context, data mwsCustomerManagedKeysParams, a *databricks.AccountClient) (*mwsCustomerManagedKeys, error) {
+		list, err := a.EncryptionKeys.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// keys don't have names, so they are identified by their ARN or Cloud KMS resource ID
+		ids := map[string]string{}
+		for _, k := range list {
+			if matchesFilter(data.Region, keyRegion(k)) && matchesFilter(data.UseCase, keyUseCases(k)...) {
+				if err := addId("customer managed key", ids, keyIdentifier(k), k.CustomerManagedKeyId); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return &mwsCustomerManagedKeys{Ids: ids}, nil
+	})
+}
@@ -0,0 +1,40 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestDataSourceMwsCustomerManagedKeys(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockEncryptionKeysAPI().EXPECT().List(mock.Anything).Return(getTestCustomerManagedKeys(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsCustomerManagedKeys(),
+		ID:          "_",
+		HCL:         `use_case = "MANAGED_SERVICES"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids": map[string]any{
+			"arn:aws:kms:us-east-1:123:key/k1":                           "cmk1",
+			"projects/p/locations/europe-west1/keyRings/r/cryptoKeys/k3": "cmk3",
+		},
+	})
+}
+
+func TestDataSourceMwsCustomerManagedKeys_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		AccountID:   "abc",
+		Resource:    DataSourceMwsCustomerManagedKeys(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
@@ -0,0 +1,109 @@
+package mws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/service/provisioning"
+)
+
+// matchesFilter returns true if the filter is not set or is equal to one of the values
+func matchesFilter(filter string, values ...string) bool {
+	if filter == "" {
+		return true
+	}
+	for _, v := range values {
+		if v == filter {
+			return true
+		}
+	}
+	return false
+}
+
+// findSingle returns the only item that matches the filters, so that data sources don't pick a random one
+func findSingle[T any](kind string, items []T, matches func(T) bool) (*T, error) {
+	var found []T
+	for _, v := range items {
+		if matches(v) {
+			found = append(found, v)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no %s matches the given filters", kind)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("%d objects of type %s match the given filters, use more specific filters",
+			len(found), kind)
+	}
+}
+
+// addId adds the ID under the given name, failing if another object has the same name, as
+// the data source would return a random one of them otherwise
+func addId(kind string, ids map[string]string, name, id string) error {
+	if existing, ok := ids[name]; ok && existing != id {
+		return fmt.Errorf("there are multiple objects of type %s named %s: %s and %s, use more specific filters",
+			kind, name, existing, id)
+	}
+	ids[name] = id
+	return nil
+}
+
+// networkRegion returns the region of subnets of GCP networks, as AWS networks don't have a region
+func networkRegion(n provisioning.Network) string {
+	if n.GcpNetworkInfo == nil {
+		return ""
+	}
+	return n.GcpNetworkInfo.SubnetRegion
+}
+
+func networkVpcIds(n provisioning.Network) []string {
+	if n.GcpNetworkInfo == nil {
+		return []string{n.VpcId}
+	}
+	return []string{n.VpcId, n.GcpNetworkInfo.VpcId}
+}
+
+func vpcEndpointRegion(e provisioning.VpcEndpoint) string {
+	if e.GcpVpcEndpointInfo != nil {
+		return e.GcpVpcEndpointInfo.EndpointRegion
+	}
+	return e.Region
+}
+
+// keyIdentifier returns the ARN of AWS KMS keys or the resource ID of GCP Cloud KMS keys
+func keyIdentifier(k provisioning.CustomerManagedKey) string {
+	if k.AwsKeyInfo != nil {
+		return k.AwsKeyInfo.KeyArn
+	}
+	if k.GcpKeyInfo != nil {
+		return k.GcpKeyInfo.KmsKeyId
+	}
+	return k.CustomerManagedKeyId
+}
+
+// keyRegion returns the region of AWS KMS keys or the location of GCP Cloud KMS keys, like
+// projects/<project>/locations/<location>/keyRings/<ring>/cryptoKeys/<key>
+func keyRegion(k provisioning.CustomerManagedKey) string {
+	if k.AwsKeyInfo != nil {
+		return k.AwsKeyInfo.KeyRegion
+	}
+	if k.GcpKeyInfo != nil {
+		parts := strings.Split(k.GcpKeyInfo.KmsKeyId, "/")
+		for i := 0; i < len(parts)-1; i++ {
+			if parts[i] == "locations" {
+				return parts[i+1]
+			}
+		}
+	}
+	return ""
+}
+
+func keyUseCases(k provisioning.CustomerManagedKey) []string {
+	useCases := make([]string, 0, len(k.UseCases))
+	for _, v := range k.UseCases {
+		useCases = append(useCases, string(v))
+	}
+	return useCases
+}
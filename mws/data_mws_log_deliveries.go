@@ -0,0 +1,48 @@
+package mws
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/billing"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+func DataSourceMwsLogDeliveries() common.Resource {
+	type mwsLogDeliveries struct {
+		Ids map[string]string `json:"ids,omitempty" tf:"computed"`
+	}
+
+	type mwsLogDeliveriesParams struct {
+		LogType                string `json:"log_type" tf:"optional"`
+		Status                 string `json:"status" tf:"optional"`
+		CredentialsId          string `json:"credentials_id" tf:"optional"`
+		StorageConfigurationId string `json:"storage_configuration_id" tf:"optional"`
+	}
+
+	return common.AccountDataWithParams(func(ctx context.Context, data mwsLogDeliveriesParams, a *databricks.AccountClient) (*mwsLogDeliveries, error) {
+		list, err := a.LogDelivery.ListAll(ctx, billing.ListLogDeliveryRequest{
+			Status:                 billing.LogDeliveryConfigStatus(data.Status),
+			CredentialsId:          data.CredentialsId,
+			StorageConfigurationId: data.StorageConfigurationId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		ids := map[string]string{}
+		for _, l := range list {
+			if !matchesFilter(data.LogType, string(l.LogType)) {
+				continue
+			}
+			// config_name is optional
+			name := l.ConfigName
+			if name == "" {
+				name = l.ConfigId
+			}
+			if err := addId("log delivery configuration", ids, name, l.ConfigId); err != nil {
+				return nil, err
+			}
+		}
+		return &mwsLogDeliveries{Ids: ids}, nil
+	})
+}
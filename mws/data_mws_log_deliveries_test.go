@@ -0,0 +1,62 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/billing"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestDataSourceMwsLogDeliveries(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockLogDeliveryAPI().EXPECT().ListAll(mock.Anything, billing.ListLogDeliveryRequest{
+				CredentialsId: "c1",
+			}).Return(getTestLogDeliveries(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsLogDeliveries(),
+		ID:          "_",
+		HCL:         `credentials_id = "c1"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids": map[string]any{
+			"audit": "ld1",
+			"ld2":   "ld2",
+		},
+	})
+}
+
+func TestDataSourceMwsLogDeliveries_LogType(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockLogDeliveryAPI().EXPECT().ListAll(mock.Anything, billing.ListLogDeliveryRequest{}).
+				Return(getTestLogDeliveries(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsLogDeliveries(),
+		ID:          "_",
+		HCL:         `log_type = "BILLABLE_USAGE"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids": map[string]any{
+			"ld2": "ld2",
+		},
+	})
+}
+
+func TestDataSourceMwsLogDeliveries_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		AccountID:   "abc",
+		Resource:    DataSourceMwsLogDeliveries(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
@@ -0,0 +1,40 @@
+package mws
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/billing"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+func DataSourceMwsLogDelivery() common.Resource {
+	type mwsLogDelivery struct {
+		billing.LogDeliveryConfiguration
+	}
+
+	type mwsLogDeliveryParams struct {
+		ConfigId   string `json:"config_id" tf:"computed,optional"`
+		ConfigName string `json:"config_name" tf:"computed,optional"`
+		LogType    string `json:"log_type" tf:"computed,optional"`
+		Status     string `json:"status" tf:"computed,optional"`
+	}
+
+	return common.AccountDataWithParams(func(ctx context.Context, data mwsLogDeliveryParams, a *databricks.AccountClient) (*mwsLogDelivery, error) {
+		list, err := a.LogDelivery.ListAll(ctx, billing.ListLogDeliveryRequest{
+			Status: billing.LogDeliveryConfigStatus(data.Status),
+		})
+		if err != nil {
+			return nil, err
+		}
+		config, err := findSingle("log delivery configuration", list, func(l billing.LogDeliveryConfiguration) bool {
+			return matchesFilter(data.ConfigId, l.ConfigId) &&
+				matchesFilter(data.ConfigName, l.ConfigName) &&
+				matchesFilter(data.LogType, string(l.LogType))
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &mwsLogDelivery{LogDeliveryConfiguration: *config}, nil
+	})
+}
@@ -0,0 +1,83 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/billing"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func getTestLogDeliveries() []billing.LogDeliveryConfiguration {
+	return []billing.LogDeliveryConfiguration{
+		{
+			ConfigId:               "ld1",
+			ConfigName:             "audit",
+			LogType:                billing.LogTypeAuditLogs,
+			OutputFormat:           billing.OutputFormatJson,
+			CredentialsId:          "c1",
+			StorageConfigurationId: "sc1",
+			Status:                 billing.LogDeliveryConfigStatusEnabled,
+		},
+		{
+			ConfigId:               "ld2",
+			LogType:                billing.LogTypeBillableUsage,
+			OutputFormat:           billing.OutputFormatCsv,
+			CredentialsId:          "c1",
+			StorageConfigurationId: "sc1",
+			Status:                 billing.LogDeliveryConfigStatusEnabled,
+		},
+	}
+}
+
+func TestDataSourceMwsLogDelivery(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockLogDeliveryAPI().EXPECT().ListAll(mock.Anything, billing.ListLogDeliveryRequest{
+				Status: billing.LogDeliveryConfigStatusEnabled,
+			}).Return(getTestLogDeliveries(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsLogDelivery(),
+		ID:          "_",
+		HCL: `
+		log_type = "AUDIT_LOGS"
+		status = "ENABLED"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"config_id":                "ld1",
+		"config_name":              "audit",
+		"output_format":            "JSON",
+		"credentials_id":           "c1",
+		"storage_configuration_id": "sc1",
+	})
+}
+
+func TestDataSourceMwsLogDelivery_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockLogDeliveryAPI().EXPECT().ListAll(mock.Anything, billing.ListLogDeliveryRequest{}).
+				Return(getTestLogDeliveries(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsLogDelivery(),
+		ID:          "_",
+		HCL:         `config_name = "billing"`,
+	}.ExpectError(t, "no log delivery configuration matches the given filters")
+}
+
+func TestDataSourceMwsLogDelivery_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		AccountID:   "abc",
+		Resource:    DataSourceMwsLogDelivery(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
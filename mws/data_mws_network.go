@@ -0,0 +1,39 @@
+package mws
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/provisioning"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+func DataSourceMwsNetwork() common.Resource {
+	type mwsNetwork struct {
+		provisioning.Network
+	}
+
+	type mwsNetworkParams struct {
+		NetworkId   string `json:"network_id" tf:"computed,optional"`
+		NetworkName string `json:"network_name" tf:"computed,optional"`
+		VpcId       string `json:"vpc_id" tf:"computed,optional"`
+		Region      string `json:"region" tf:"optional"`
+	}
+
+	return common.AccountDataWithParams(func(ctx context.Context, data mwsNetworkParams, a *databricks.AccountClient) (*mwsNetwork, error) {
+		list, err := a.Networks.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		network, err := findSingle("network", list, func(n provisioning.Network) bool {
+			return matchesFilter(data.NetworkId, n.NetworkId) &&
+				matchesFilter(data.NetworkName, n.NetworkName) &&
+				matchesFilter(data.VpcId, networkVpcIds(n)...) &&
+				matchesFilter(data.Region, networkRegion(n))
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &mwsNetwork{Network: *network}, nil
+	})
+}
@@ -0,0 +1,114 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/provisioning"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func getTestNetworks() []provisioning.Network {
+	return []provisioning.Network{
+		{
+			AccountId:        "abc",
+			NetworkId:        "n1",
+			NetworkName:      "shared-us-east-1",
+			VpcId:            "vpc-1",
+			SubnetIds:        []string{"subnet-1", "subnet-2"},
+			SecurityGroupIds: []string{"sg-1"},
+		},
+		{
+			AccountId:   "abc",
+			NetworkId:   "n2",
+			NetworkName: "shared-europe-west1",
+			GcpNetworkInfo: &provisioning.GcpNetworkInfo{
+				NetworkProjectId: "network-project",
+				VpcId:            "vpc-2",
+				SubnetId:         "subnet-3",
+				SubnetRegion:     "europe-west1",
+			},
+		},
+	}
+}
+
+func TestDataSourceMwsNetwork(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockNetworksAPI().EXPECT().List(mock.Anything).Return(getTestNetworks(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsNetwork(),
+		ID:          "_",
+		HCL:         `vpc_id = "vpc-1"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"network_id":         "n1",
+		"network_name":       "shared-us-east-1",
+		"vpc_id":             "vpc-1",
+		"subnet_ids":         []any{"subnet-1", "subnet-2"},
+		"security_group_ids": []any{"sg-1"},
+		"gcp_network_info.#": 0,
+		"account_id":         "abc",
+	})
+}
+
+func TestDataSourceMwsNetwork_Region(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockNetworksAPI().EXPECT().List(mock.Anything).Return(getTestNetworks(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsNetwork(),
+		ID:          "_",
+		HCL:         `region = "europe-west1"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"network_id":                            "n2",
+		"gcp_network_info.0.network_project_id": "network-project",
+		"gcp_network_info.0.vpc_id":             "vpc-2",
+	})
+}
+
+func TestDataSourceMwsNetwork_Ambiguous(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockNetworksAPI().EXPECT().List(mock.Anything).Return(getTestNetworks(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsNetwork(),
+		ID:          "_",
+	}.ExpectError(t, "2 objects of type network match the given filters, use more specific filters")
+}
+
+func TestDataSourceMwsNetwork_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockNetworksAPI().EXPECT().List(mock.Anything).Return(getTestNetworks(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsNetwork(),
+		ID:          "_",
+		HCL:         `network_name = "other"`,
+	}.ExpectError(t, "no network matches the given filters")
+}
+
+func TestDataSourceMwsNetwork_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		AccountID:   "abc",
+		Resource:    DataSourceMwsNetwork(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `network_name = "shared"`,
+	}.ExpectError(t, "i'm a teapot")
+}
@@ -0,0 +1,35 @@
+package mws
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+func DataSourceMwsNetworks() common.Resource {
+	type mwsNetworks struct {
+		Ids map[string]string `json:"ids,omitempty" tf:"computed"`
+	}
+
+	type mwsNetworksParams struct {
+		VpcId  string `json:"vpc_id" tf:"optional"`
+		Region string `json:"region" tf:"optional"`
+	}
+
+	return common.AccountDataWithParams(func(ctx context.Context, data mwsNetworksParams, a *databricks.AccountClient) (*mwsNetworks, error) {
+		list, err := a.Networks.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids := map[string]string{}
+		for _, n := range list {
+			if matchesFilter(data.VpcId, networkVpcIds(n)...) && matchesFilter(data.Region, networkRegion(n)) {
+				if err := addId("network", ids, n.NetworkName, n.NetworkId); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return &mwsNetworks{Ids: ids}, nil
+	})
+}
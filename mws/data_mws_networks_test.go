@@ -0,0 +1,73 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestDataSourceMwsNetworks(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockNetworksAPI().EXPECT().List(mock.Anything).Return(getTestNetworks(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsNetworks(),
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids": map[string]any{
+			"shared-us-east-1":    "n1",
+			"shared-europe-west1": "n2",
+		},
+	})
+}
+
+func TestDataSourceMwsNetworks_Filter(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockNetworksAPI().EXPECT().List(mock.Anything).Return(getTestNetworks(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsNetworks(),
+		ID:          "_",
+		HCL:         `vpc_id = "vpc-2"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids": map[string]any{
+			"shared-europe-west1": "n2",
+		},
+	})
+}
+
+func TestDataSourceMwsNetworks_DuplicateNames(t *testing.T) {
+	networks := getTestNetworks()
+	networks[1].NetworkName = networks[0].NetworkName
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockNetworksAPI().EXPECT().List(mock.Anything).Return(networks, nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsNetworks(),
+		ID:          "_",
+	}.ExpectError(t, "there are multiple objects of type network named shared-us-east-1: n1 and n2, "+
+		"use more specific filters")
+}
+
+func TestDataSourceMwsNetworks_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		AccountID:   "abc",
+		Resource:    DataSourceMwsNetworks(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
@@ -0,0 +1,37 @@
+package mws
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/provisioning"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+func DataSourceMwsPrivateAccessSetting() common.Resource {
+	type mwsPrivateAccessSettings struct {
+		provisioning.PrivateAccessSettings
+	}
+
+	type mwsPrivateAccessSettingsParams struct {
+		PrivateAccessSettingsId   string `json:"private_access_settings_id" tf:"computed,optional"`
+		PrivateAccessSettingsName string `json:"private_access_settings_name" tf:"computed,optional"`
+		Region                    string `json:"region" tf:"computed,optional"`
+	}
+
+	return common.AccountDataWithParams(func(ctx context.Context, data mwsPrivateAccessSettingsParams, a *databricks.AccountClient) (*mwsPrivateAccessSettings, error) {
+		list, err := a.PrivateAccess.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pas, err := findSingle("private access settings", list, func(p provisioning.PrivateAccessSettings) bool {
+			return matchesFilter(data.PrivateAccessSettingsId, p.PrivateAccessSettingsId) &&
+				matchesFilter(data.PrivateAccessSettingsName, p.PrivateAccessSettingsName) &&
+				matchesFilter(data.Region, p.Region)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &mwsPrivateAccessSettings{PrivateAccessSettings: *pas}, nil
+	})
+}
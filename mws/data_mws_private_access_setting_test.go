@@ -0,0 +1,75 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/provisioning"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func getTestPrivateAccessSettings() []provisioning.PrivateAccessSettings {
+	return []provisioning.PrivateAccessSettings{
+		{
+			PrivateAccessSettingsId:   "pas1",
+			PrivateAccessSettingsName: "shared",
+			Region:                    "us-east-1",
+			PrivateAccessLevel:        provisioning.PrivateAccessLevelAccount,
+			PublicAccessEnabled:       true,
+		},
+		{
+			PrivateAccessSettingsId:   "pas2",
+			PrivateAccessSettingsName: "shared",
+			Region:                    "eu-west-1",
+			PrivateAccessLevel:        provisioning.PrivateAccessLevelEndpoint,
+			AllowedVpcEndpointIds:     []string{"ve1"},
+		},
+	}
+}
+
+func TestDataSourceMwsPrivateAccessSetting(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockPrivateAccessAPI().EXPECT().List(mock.Anything).Return(getTestPrivateAccessSettings(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsPrivateAccessSetting(),
+		ID:          "_",
+		HCL: `
+		private_access_settings_name = "shared"
+		region = "eu-west-1"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"private_access_settings_id": "pas2",
+		"private_access_level":       "ENDPOINT",
+		"allowed_vpc_endpoint_ids":   []any{"ve1"},
+	})
+}
+
+func TestDataSourceMwsPrivateAccessSetting_Ambiguous(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockPrivateAccessAPI().EXPECT().List(mock.Anything).Return(getTestPrivateAccessSettings(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsPrivateAccessSetting(),
+		ID:          "_",
+		HCL:         `private_access_settings_name = "shared"`,
+	}.ExpectError(t, "2 objects of type private access settings match the given filters, use more specific filters")
+}
+
+func TestDataSourceMwsPrivateAccessSetting_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		AccountID:   "abc",
+		Resource:    DataSourceMwsPrivateAccessSetting(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
@@ -0,0 +1,34 @@
+package mws
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+func DataSourceMwsPrivateAccessSettings() common.Resource {
+	type mwsPrivateAccessSettings struct {
+		Ids map[string]string `json:"ids,omitempty" tf:"computed"`
+	}
+
+	type mwsPrivateAccessSettingsParams struct {
+		Region string `json:"region" tf:"optional"`
+	}
+
+	return common.AccountDataWithParams(func(ctx context.Context, data mwsPrivateAccessSettingsParams, a *databricks.AccountClient) (*mwsPrivateAccessSettings, error) {
+		list, err := a.PrivateAccess.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids := map[string]string{}
+		for _, p := range list {
+			if matchesFilter(data.Region, p.Region) {
+				if err := addId("private access settings", ids, p.PrivateAccessSettingsName, p.PrivateAccessSettingsId); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return &mwsPrivateAccessSettings{Ids: ids}, nil
+	})
+}
@@ -0,0 +1,39 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestDataSourceMwsPrivateAccessSettings(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockPrivateAccessAPI().EXPECT().List(mock.Anything).Return(getTestPrivateAccessSettings(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsPrivateAccessSettings(),
+		ID:          "_",
+		HCL:         `region = "us-east-1"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids": map[string]any{
+			"shared": "pas1",
+		},
+	})
+}
+
+func TestDataSourceMwsPrivateAccessSettings_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		AccountID:   "abc",
+		Resource:    DataSourceMwsPrivateAccessSettings(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
@@ -0,0 +1,44 @@
+package mws
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/provisioning"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+func storageBucketName(s provisioning.StorageConfiguration) string {
+	if s.RootBucketInfo == nil {
+		return ""
+	}
+	return s.RootBucketInfo.BucketName
+}
+
+func DataSourceMwsStorageConfiguration() common.Resource {
+	type mwsStorageConfiguration struct {
+		provisioning.StorageConfiguration
+	}
+
+	type mwsStorageConfigurationParams struct {
+		StorageConfigurationId   string `json:"storage_configuration_id" tf:"computed,optional"`
+		StorageConfigurationName string `json:"storage_configuration_name" tf:"computed,optional"`
+		BucketName               string `json:"bucket_name" tf:"optional"`
+	}
+
+	return common.AccountDataWithParams(func(ctx context.Context, data mwsStorageConfigurationParams, a *databricks.AccountClient) (*mwsStorageConfiguration, error) {
+		list, err := a.Storage.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		storage, err := findSingle("storage configuration", list, func(s provisioning.StorageConfiguration) bool {
+			return matchesFilter(data.StorageConfigurationId, s.StorageConfigurationId) &&
+				matchesFilter(data.StorageConfigurationName, s.StorageConfigurationName) &&
+				matchesFilter(data.BucketName, storageBucketName(s))
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &mwsStorageConfiguration{StorageConfiguration: *storage}, nil
+	})
+}
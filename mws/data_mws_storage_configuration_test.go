@@ -0,0 +1,60 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/provisioning"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func getTestStorageConfigurations() []provisioning.StorageConfiguration {
+	return []provisioning.StorageConfiguration{
+		{
+			StorageConfigurationId:   "sc1",
+			StorageConfigurationName: "root-prod",
+			RootBucketInfo: &provisioning.RootBucketInfo{
+				BucketName: "prod-root",
+			},
+		},
+		{
+			StorageConfigurationId:   "sc2",
+			StorageConfigurationName: "root-dev",
+			RootBucketInfo: &provisioning.RootBucketInfo{
+				BucketName: "dev-root",
+			},
+		},
+	}
+}
+
+func TestDataSourceMwsStorageConfiguration(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockStorageAPI().EXPECT().List(mock.Anything).Return(getTestStorageConfigurations(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsStorageConfiguration(),
+		ID:          "_",
+		HCL:         `bucket_name = "dev-root"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"storage_configuration_id":       "sc2",
+		"storage_configuration_name":     "root-dev",
+		"root_bucket_info.0.bucket_name": "dev-root",
+	})
+}
+
+func TestDataSourceMwsStorageConfiguration_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		AccountID:   "abc",
+		Resource:    DataSourceMwsStorageConfiguration(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `storage_configuration_name = "root-dev"`,
+	}.ExpectError(t, "i'm a teapot")
+}
@@ -0,0 +1,34 @@
+package mws
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+func DataSourceMwsStorageConfigurations() common.Resource {
+	type mwsStorageConfigurations struct {
+		Ids map[string]string `json:"ids,omitempty" tf:"computed"`
+	}
+
+	type mwsStorageConfigurationsParams struct {
+		BucketName string `json:"bucket_name" tf:"optional"`
+	}
+
+	return common.AccountDataWithParams(func(ctx context.Context, data mwsStorageConfigurationsParams, a *databricks.AccountClient) (*mwsStorageConfigurations, error) {
+		list, err := a.Storage.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids := map[string]string{}
+		for _, s := range list {
+			if matchesFilter(data.BucketName, storageBucketName(s)) {
+				if err := addId("storage configuration", ids, s.StorageConfigurationName, s.StorageConfigurationId); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return &mwsStorageConfigurations{Ids: ids}, nil
+	})
+}
@@ -0,0 +1,41 @@
+package mws
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/provisioning"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+func DataSourceMwsVpcEndpoint() common.Resource {
+	type mwsVpcEndpoint struct {
+		provisioning.VpcEndpoint
+	}
+
+	type mwsVpcEndpointParams struct {
+		VpcEndpointId    string `json:"vpc_endpoint_id" tf:"computed,optional"`
+		VpcEndpointName  string `json:"vpc_endpoint_name" tf:"computed,optional"`
+		AwsVpcEndpointId string `json:"aws_vpc_endpoint_id" tf:"computed,optional"`
+		Region           string `json:"region" tf:"computed,optional"`
+		UseCase          string `json:"use_case" tf:"computed,optional"`
+	}
+
+	return common.AccountDataWithParams(func(ctx context.Context, data mwsVpcEndpointParams, a *databricks.AccountClient) (*mwsVpcEndpoint, error) {
+		list, err := a.VpcEndpoints.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		endpoint, err := findSingle("VPC endpoint", list, func(e provisioning.VpcEndpoint) bool {
+			return matchesFilter(data.VpcEndpointId, e.VpcEndpointId) &&
+				matchesFilter(data.VpcEndpointName, e.VpcEndpointName) &&
+				matchesFilter(data.AwsVpcEndpointId, e.AwsVpcEndpointId) &&
+				matchesFilter(data.Region, vpcEndpointRegion(e)) &&
+				matchesFilter(data.UseCase, string(e.UseCase))
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &mwsVpcEndpoint{VpcEndpoint: *endpoint}, nil
+	})
+}
@@ -0,0 +1,107 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/provisioning"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func getTestVpcEndpoints() []provisioning.VpcEndpoint {
+	return []provisioning.VpcEndpoint{
+		{
+			VpcEndpointId:        "ve1",
+			VpcEndpointName:      "relay",
+			AwsVpcEndpointId:     "vpce-1",
+			AwsEndpointServiceId: "com.amazonaws.vpce.us-east-1.vpce-svc-1",
+			Region:               "us-east-1",
+			State:                "available",
+			UseCase:              provisioning.EndpointUseCaseDataplaneRelayAccess,
+		},
+		{
+			VpcEndpointId:    "ve2",
+			VpcEndpointName:  "rest-api",
+			AwsVpcEndpointId: "vpce-2",
+			Region:           "us-east-1",
+			State:            "available",
+			UseCase:          provisioning.EndpointUseCaseWorkspaceAccess,
+		},
+		{
+			VpcEndpointId:   "ve3",
+			VpcEndpointName: "psc",
+			GcpVpcEndpointInfo: &provisioning.GcpVpcEndpointInfo{
+				EndpointRegion:  "europe-west1",
+				ProjectId:       "network-project",
+				PscEndpointName: "psc-endpoint",
+			},
+			UseCase: provisioning.EndpointUseCaseWorkspaceAccess,
+		},
+	}
+}
+
+func TestDataSourceMwsVpcEndpoint(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockVpcEndpointsAPI().EXPECT().List(mock.Anything).Return(getTestVpcEndpoints(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsVpcEndpoint(),
+		ID:          "_",
+		HCL: `
+		region = "us-east-1"
+		use_case = "DATAPLANE_RELAY_ACCESS"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"vpc_endpoint_id":         "ve1",
+		"vpc_endpoint_name":       "relay",
+		"aws_vpc_endpoint_id":     "vpce-1",
+		"aws_endpoint_service_id": "com.amazonaws.vpce.us-east-1.vpce-svc-1",
+		"state":                   "available",
+	})
+}
+
+func TestDataSourceMwsVpcEndpoint_Gcp(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockVpcEndpointsAPI().EXPECT().List(mock.Anything).Return(getTestVpcEndpoints(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsVpcEndpoint(),
+		ID:          "_",
+		HCL:         `region = "europe-west1"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"vpc_endpoint_id": "ve3",
+		"gcp_vpc_endpoint_info.0.psc_endpoint_name": "psc-endpoint",
+	})
+}
+
+func TestDataSourceMwsVpcEndpoint_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockVpcEndpointsAPI().EXPECT().List(mock.Anything).Return(getTestVpcEndpoints(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsVpcEndpoint(),
+		ID:          "_",
+		HCL:         `aws_vpc_endpoint_id = "vpce-3"`,
+	}.ExpectError(t, "no VPC endpoint matches the given filters")
+}
+
+func TestDataSourceMwsVpcEndpoint_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		AccountID:   "abc",
+		Resource:    DataSourceMwsVpcEndpoint(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
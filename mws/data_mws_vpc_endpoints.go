@@ -0,0 +1,35 @@
+package mws
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+func DataSourceMwsVpcEndpoints() common.Resource {
+	type mwsVpcEndpoints struct {
+		Ids map[string]string `json:"ids,omitempty" tf:"computed"`
+	}
+
+	type mwsVpcEndpointsParams struct {
+		Region  string `json:"region" tf:"optional"`
+		UseCase string `json:"use_case" tf:"optional"`
+	}
+
+	return common.AccountDataWithParams(func(ctx context.Context, data mwsVpcEndpointsParams, a *databricks.AccountClient) (*mwsVpcEndpoints, error) {
+		list, err := a.VpcEndpoints.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids := map[string]string{}
+		for _, e := range list {
+			if matchesFilter(data.Region, vpcEndpointRegion(e)) && matchesFilter(data.UseCase, string(e.UseCase)) {
+				if err := addId("VPC endpoint", ids, e.VpcEndpointName, e.VpcEndpointId); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return &mwsVpcEndpoints{Ids: ids}, nil
+	})
+}
@@ -0,0 +1,40 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestDataSourceMwsVpcEndpoints(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockVpcEndpointsAPI().EXPECT().List(mock.Anything).Return(getTestVpcEndpoints(), nil)
+		},
+		AccountID:   "abc",
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceMwsVpcEndpoints(),
+		ID:          "_",
+		HCL:         `use_case = "WORKSPACE_ACCESS"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids": map[string]any{
+			"rest-api": "ve2",
+			"psc":      "ve3",
+		},
+	})
+}
+
+func TestDataSourceMwsVpcEndpoints_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		AccountID:   "abc",
+		Resource:    DataSourceMwsVpcEndpoints(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
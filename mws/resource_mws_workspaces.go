@@ -179,23 +179,32 @@ func (a WorkspacesAPI) Create(ws *Workspace, timeout time.Duration) error {
 // generateWorkspaceHostname computes the hostname for the specified workspace,
 // given the account console hostname.
 func generateWorkspaceHostname(client *common.DatabricksClient, ws Workspace) string {
-	u, err := url.Parse(client.Config.Host)
+	return WorkspaceHostname(client.Config.Host, ws.DeploymentName)
+}
+
+// WorkspaceHostname computes the hostname of the workspace with the given deployment name,
+// given the account console host.
+func WorkspaceHostname(accountsHost, deploymentName string) string {
+	if !strings.Contains(accountsHost, "://") {
+		accountsHost = "https://" + accountsHost
+	}
+	u, err := url.Parse(accountsHost)
 	if err != nil {
 		// Fallback.
 		log.Printf("[WARN] Unable to parse URL from client host: %v", err)
-		return ws.DeploymentName + ".cloud.databricks.com"
+		return deploymentName + ".cloud.databricks.com"
 	}
 
 	// We expect the account console hostname to be of the form `accounts.foo[.bar]...`
 	// The workspace hostname can be generated by replacing `accounts` with the deployment name.
 	// If the hostname is an IP address, we're in testing mode and do fallback.
 	chunks := strings.Split(u.Hostname(), ".")
-	if len(chunks) == 0 || net.ParseIP(u.Hostname()) != nil {
+	if u.Hostname() == "" || net.ParseIP(u.Hostname()) != nil {
 		// Fallback.
 		log.Printf("[WARN] Unable to split client host: %v", u.Hostname())
-		return ws.DeploymentName + ".cloud.databricks.com"
+		return deploymentName + ".cloud.databricks.com"
 	}
-	chunks[0] = ws.DeploymentName
+	chunks[0] = deploymentName
 	return strings.Join(chunks, ".")
 }
 
@@ -566,6 +575,7 @@ func ResourceMwsWorkspaces() common.Resource {
 	return common.Resource{
 		Schema:        workspaceSchema,
 		SchemaVersion: 3,
+		Identity:      p.Identity(),
 		StateUpgraders: []schema.StateUpgrader{
 			{
 				Version: 2,
@@ -1515,6 +1515,12 @@ func TestGenerateWorkspaceHostname_CornerCases(t *testing.T) {
 		}))
 }
 
+func TestWorkspaceHostname(t *testing.T) {
+	assert.Equal(t, "stuff.gcp.databricks.com", WorkspaceHostname("https://accounts.gcp.databricks.com", "stuff"))
+	assert.Equal(t, "stuff.cloud.databricks.com", WorkspaceHostname("accounts.cloud.databricks.com", "stuff"))
+	assert.Equal(t, "stuff.cloud.databricks.com", WorkspaceHostname("https://127.0.0.1:8443", "stuff"))
+}
+
 func TestExplainWorkspaceFailureCornerCase(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
@@ -66,6 +66,40 @@ func Read(w *databricks.WorkspaceClient, ctx context.Context, id string) (*pipel
 	})
 }
 
+// userActionEvent is an event of the pipeline event log. The SDK doesn't expose details of events,
+// which have the user, who made the change.
+type userActionEvent struct {
+	EventType string `json:"event_type"`
+	Details   struct {
+		UserAction struct {
+			Action   string `json:"action"`
+			UserName string `json:"user_name"`
+		} `json:"user_action"`
+	} `json:"details"`
+}
+
+// lastEditor returns the user, who last edited settings of the pipeline, according to the latest
+// events of the pipeline event log. It's empty if the edit isn't in the latest events.
+func lastEditor(ctx context.Context, c *common.DatabricksClient, id string) string {
+	var resp struct {
+		Events []userActionEvent `json:"events"`
+	}
+	err := c.Get(ctx, fmt.Sprintf("/pipelines/%s/events", id), map[string]any{
+		"order_by":    "timestamp desc",
+		"max_results": 100,
+	}, &resp)
+	if err != nil {
+		log.Printf("[WARN] can't read event log of pipeline %s: %s", id, err)
+		return ""
+	}
+	for _, event := range resp.Events {
+		if event.EventType == "user_action" && event.Details.UserAction.Action == "EDIT" {
+			return event.Details.UserAction.UserName
+		}
+	}
+	return ""
+}
+
 func Update(w *databricks.WorkspaceClient, ctx context.Context, d *schema.ResourceData, timeout time.Duration) error {
 	var updatePipelineRequest updatePipelineRequestStruct
 	common.DataToStructPointer(d, pipelineSchema, &updatePipelineRequest)
@@ -201,6 +235,10 @@ func suppressStorageDiff(k, old, new string, d *schema.ResourceData) bool {
 	return false
 }
 
+// computed attributes that change without anyone editing the pipeline
+var pipelineStatusFields = []string{"state", "latest_updates", "last_modified", "health", "cause",
+	"cluster_id", "creator_user_name", "url", "run_as_user_name"}
+
 func (Pipeline) CustomizeSchema(s *common.CustomizableSchema) *common.CustomizableSchema {
 
 	// ForceNew fields
@@ -222,10 +260,10 @@ func (Pipeline) CustomizeSchema(s *common.CustomizableSchema) *common.Customizab
 	s.SchemaPath("cluster", "driver_node_type_id").SetComputed()
 	s.SchemaPath("cluster", "enable_local_disk_encryption").SetComputed()
 
-	for _, field := range []string{"id", "state", "latest_updates", "last_modified",
-		"health", "cause", "cluster_id", "creator_user_name", "run_as", "url", "run_as_user_name"} {
+	for _, field := range append([]string{"id", "run_as"}, pipelineStatusFields...) {
 		s.SchemaPath(field).SetComputed()
 	}
+	s.AddDriftFields().AddLastModifiedByField()
 
 	// customize event_log
 	s.SchemaPath("event_log", "name").SetRequired()
@@ -287,7 +325,8 @@ var pipelineSchema = common.StructToSchema(Pipeline{}, nil)
 
 func ResourcePipeline() common.Resource {
 	return common.Resource{
-		Schema: pipelineSchema,
+		Schema:   pipelineSchema,
+		Identity: common.NewIdentity("pipeline_id"),
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
@@ -300,6 +339,7 @@ func ResourcePipeline() common.Resource {
 			if err != nil {
 				return err
 			}
+			drift := common.NewDrift(d, pipelineStatusFields...)
 			readPipeline, err := Read(w, ctx, d.Id())
 
 			if err != nil {
@@ -332,13 +372,20 @@ func ResourcePipeline() common.Resource {
 					}
 				}
 			}
-			return common.StructToData(p, pipelineSchema, d)
+			err = common.StructToData(p, pipelineSchema, d)
+			if err != nil {
+				return err
+			}
+			return drift.Check(ctx, d, func() string {
+				return lastEditor(ctx, c, d.Id())
+			})
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
 				return err
 			}
+			common.ResetDrift(d)
 			return Update(w, ctx, d, d.Timeout(schema.TimeoutUpdate))
 
 		},
@@ -345,6 +345,68 @@ func TestResourcePipelineRead(t *testing.T) {
 	})
 }
 
+func TestResourcePipelineRead_Drift(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/pipelines/abcd?",
+				Response: pipelines.GetPipelineResponse{
+					PipelineId: "abcd",
+					Spec: &pipelines.PipelineSpec{
+						Name:    "changed-in-ui",
+						Storage: "/test/storage",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/pipelines/abcd/events?max_results=100&order_by=timestamp%20desc",
+				Response: map[string]any{
+					"events": []any{
+						map[string]any{
+							"event_type": "update_progress",
+						},
+						map[string]any{
+							"event_type": "user_action",
+							"details": map[string]any{
+								"user_action": map[string]any{
+									"action":    "START",
+									"user_name": "runner@example.com",
+								},
+							},
+						},
+						map[string]any{
+							"event_type": "user_action",
+							"details": map[string]any{
+								"user_action": map[string]any{
+									"action":    "EDIT",
+									"user_name": "editor@example.com",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePipeline(),
+		Read:     true,
+		ID:       "abcd",
+		InstanceState: map[string]string{
+			"name":          "test-pipeline",
+			"storage":       "/test/storage",
+			"settings_hash": "applied-by-terraform",
+		},
+		HCL: `
+		name = "test-pipeline"
+		storage = "/test/storage"
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "editor@example.com", d.Get("last_modified_by"))
+	assert.Equal(t, "changed-in-ui", d.Get("name"))
+}
+
 func TestResourcePipelineRead_NotFound(t *testing.T) {
 	qa.ResourceFixture{
 		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
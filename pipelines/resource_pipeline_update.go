@@ -0,0 +1,163 @@
+package pipelines
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DefaultUpdateTimeout is the default amount of time that Terraform will wait for a pipeline update to finish.
+const DefaultUpdateTimeout = 60 * time.Minute
+
+type PipelineUpdate struct {
+	PipelineID           string            `json:"pipeline_id" tf:"force_new"`
+	FullRefresh          bool              `json:"full_refresh,omitempty" tf:"force_new"`
+	RefreshSelection     []string          `json:"refresh_selection,omitempty" tf:"force_new"`
+	FullRefreshSelection []string          `json:"full_refresh_selection,omitempty" tf:"force_new"`
+	Triggers             map[string]string `json:"triggers,omitempty" tf:"force_new"`
+	UpdateID             string            `json:"update_id,omitempty" tf:"computed"`
+	State                string            `json:"state,omitempty" tf:"computed"`
+}
+
+func (PipelineUpdate) CustomizeSchema(s *common.CustomizableSchema) *common.CustomizableSchema {
+	s.SchemaPath("full_refresh").SetConflictsWith([]string{"refresh_selection", "full_refresh_selection"})
+	s.SchemaPath("refresh_selection").SetConflictsWith([]string{"full_refresh"})
+	s.SchemaPath("full_refresh_selection").SetConflictsWith([]string{"full_refresh"})
+	return s
+}
+
+var pipelineUpdateSchema = common.StructToSchema(PipelineUpdate{}, nil)
+
+var pipelineUpdateID = common.NewPairID("pipeline_id", "update_id")
+
+func isFinalUpdateState(state pipelines.UpdateInfoState) bool {
+	switch state {
+	case pipelines.UpdateInfoStateCompleted, pipelines.UpdateInfoStateFailed, pipelines.UpdateInfoStateCanceled:
+		return true
+	}
+	return false
+}
+
+func readUpdate(w *databricks.WorkspaceClient, ctx context.Context, pipelineID, updateID string) (*pipelines.UpdateInfo, error) {
+	resp, err := w.Pipelines.GetUpdate(ctx, pipelines.GetUpdateRequest{
+		PipelineId: pipelineID,
+		UpdateId:   updateID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Update == nil {
+		return nil, fmt.Errorf("update %s of pipeline %s is not found: %w", updateID, pipelineID, apierr.ErrNotFound)
+	}
+	return resp.Update, nil
+}
+
+// WaitForUpdate waits until the pipeline update is completed, failed or canceled, and returns its final state.
+// If the update doesn't finish in time, the pipeline is stopped, so that it doesn't keep running after the error.
+func WaitForUpdate(w *databricks.WorkspaceClient, ctx context.Context, pipelineID, updateID string,
+	timeout time.Duration) (pipelines.UpdateInfoState, error) {
+	var state pipelines.UpdateInfoState
+	var readErr error
+	err := retry.RetryContext(ctx, timeout,
+		func() *retry.RetryError {
+			update, err := readUpdate(w, ctx, pipelineID, updateID)
+			if err != nil {
+				readErr = err
+				return retry.NonRetryableError(err)
+			}
+			state = update.State
+			if isFinalUpdateState(state) {
+				return nil
+			}
+			message := fmt.Sprintf("Update %s of pipeline %s is in state %s, not yet finished", updateID, pipelineID, state)
+			log.Printf("[DEBUG] %s", message)
+			return retry.RetryableError(errors.New(message))
+		})
+	if err == nil || readErr != nil {
+		return state, err
+	}
+	// the context may be already done, but the update still has to be stopped
+	_, stopErr := w.Pipelines.Stop(context.WithoutCancel(ctx), pipelines.StopRequest{
+		PipelineId: pipelineID,
+	})
+	if stopErr != nil {
+		return state, fmt.Errorf("%w, and pipeline %s can't be stopped: %w", err, pipelineID, stopErr)
+	}
+	return state, fmt.Errorf("pipeline %s is stopped: %w", pipelineID, err)
+}
+
+// ResourcePipelineUpdate starts a pipeline update when the resource is created, i.e. when `triggers`
+// change, and waits for it to finish
+func ResourcePipelineUpdate() common.Resource {
+	return common.Resource{
+		Schema: pipelineUpdateSchema,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var pu PipelineUpdate
+			common.DataToStructPointer(d, pipelineUpdateSchema, &pu)
+			resp, err := w.Pipelines.StartUpdate(ctx, pipelines.StartUpdate{
+				PipelineId:           pu.PipelineID,
+				FullRefresh:          pu.FullRefresh,
+				RefreshSelection:     pu.RefreshSelection,
+				FullRefreshSelection: pu.FullRefreshSelection,
+				Cause:                pipelines.StartUpdateCauseApiCall,
+			})
+			if err != nil {
+				return err
+			}
+			// the update is recorded even if it fails, so that the resource is tainted and started again
+			d.Set("update_id", resp.UpdateId)
+			pipelineUpdateID.Pack(d)
+			state, err := WaitForUpdate(w, ctx, pu.PipelineID, resp.UpdateId, d.Timeout(schema.TimeoutCreate))
+			d.Set("state", string(state))
+			if err != nil {
+				return err
+			}
+			if state != pipelines.UpdateInfoStateCompleted {
+				return fmt.Errorf("update %s of pipeline %s is %s", resp.UpdateId, pu.PipelineID, state)
+			}
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			pipelineID, updateID, err := pipelineUpdateID.Unpack(d)
+			if err != nil {
+				return err
+			}
+			update, err := readUpdate(w, ctx, pipelineID, updateID)
+			if apierr.IsMissing(err) {
+				// the update has happened even if it's no longer in the history of the pipeline, so the
+				// resource is kept with the last known state, instead of starting the update again
+				log.Printf("[INFO] Update %s of pipeline %s is not found, keeping the last known state %s",
+					updateID, pipelineID, d.Get("state"))
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			return d.Set("state", string(update.State))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// finished updates can't be deleted, they stay in the history of the pipeline
+			return nil
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultUpdateTimeout),
+		},
+	}
+}
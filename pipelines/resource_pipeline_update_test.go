@@ -0,0 +1,184 @@
+package pipelines
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourcePipelineUpdateCreate(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockPipelinesAPI().EXPECT()
+			e.StartUpdate(mock.Anything, pipelines.StartUpdate{
+				PipelineId:           "abcd",
+				FullRefreshSelection: []string{"sales"},
+				Cause:                pipelines.StartUpdateCauseApiCall,
+			}).Return(&pipelines.StartUpdateResponse{
+				UpdateId: "u1",
+			}, nil)
+			e.GetUpdate(mock.Anything, pipelines.GetUpdateRequest{
+				PipelineId: "abcd",
+				UpdateId:   "u1",
+			}).Return(&pipelines.GetUpdateResponse{
+				Update: &pipelines.UpdateInfo{
+					UpdateId: "u1",
+					State:    pipelines.UpdateInfoStateRunning,
+				},
+			}, nil).Once()
+			e.GetUpdate(mock.Anything, pipelines.GetUpdateRequest{
+				PipelineId: "abcd",
+				UpdateId:   "u1",
+			}).Return(&pipelines.GetUpdateResponse{
+				Update: &pipelines.UpdateInfo{
+					UpdateId: "u1",
+					State:    pipelines.UpdateInfoStateCompleted,
+				},
+			}, nil)
+		},
+		Resource: ResourcePipelineUpdate(),
+		Create:   true,
+		HCL: `
+		pipeline_id = "abcd"
+		full_refresh_selection = ["sales"]
+		triggers = {
+			schema_version = "2"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":        "abcd|u1",
+		"update_id": "u1",
+		"state":     "COMPLETED",
+	})
+}
+
+func TestResourcePipelineUpdateCreate_Failed(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockPipelinesAPI().EXPECT()
+			e.StartUpdate(mock.Anything, pipelines.StartUpdate{
+				PipelineId:  "abcd",
+				FullRefresh: true,
+				Cause:       pipelines.StartUpdateCauseApiCall,
+			}).Return(&pipelines.StartUpdateResponse{
+				UpdateId: "u1",
+			}, nil)
+			e.GetUpdate(mock.Anything, pipelines.GetUpdateRequest{
+				PipelineId: "abcd",
+				UpdateId:   "u1",
+			}).Return(&pipelines.GetUpdateResponse{
+				Update: &pipelines.UpdateInfo{
+					UpdateId: "u1",
+					State:    pipelines.UpdateInfoStateFailed,
+				},
+			}, nil)
+		},
+		Resource: ResourcePipelineUpdate(),
+		Create:   true,
+		HCL: `
+		pipeline_id = "abcd"
+		full_refresh = true`,
+	}.ExpectError(t, "update u1 of pipeline abcd is FAILED")
+}
+
+func TestResourcePipelineUpdateRead(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockPipelinesAPI().EXPECT().GetUpdate(mock.Anything, pipelines.GetUpdateRequest{
+				PipelineId: "abcd",
+				UpdateId:   "u1",
+			}).Return(&pipelines.GetUpdateResponse{
+				Update: &pipelines.UpdateInfo{
+					UpdateId: "u1",
+					State:    pipelines.UpdateInfoStateCompleted,
+				},
+			}, nil)
+		},
+		Resource: ResourcePipelineUpdate(),
+		Read:     true,
+		New:      true,
+		ID:       "abcd|u1",
+	}.ApplyAndExpectData(t, map[string]any{
+		"pipeline_id": "abcd",
+		"update_id":   "u1",
+		"state":       "COMPLETED",
+	})
+}
+
+func TestResourcePipelineUpdateRead_NotFoundKeepsState(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockPipelinesAPI().EXPECT().GetUpdate(mock.Anything, pipelines.GetUpdateRequest{
+				PipelineId: "abcd",
+				UpdateId:   "u1",
+			}).Return(nil, apierr.ErrResourceDoesNotExist)
+		},
+		Resource: ResourcePipelineUpdate(),
+		Read:     true,
+		ID:       "abcd|u1",
+		InstanceState: map[string]string{
+			"pipeline_id": "abcd",
+			"update_id":   "u1",
+			"state":       "COMPLETED",
+		},
+		HCL: `pipeline_id = "abcd"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":    "abcd|u1",
+		"state": "COMPLETED",
+	})
+}
+
+func TestResourcePipelineUpdate_Conflicts(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourcePipelineUpdate(),
+		Create:   true,
+		HCL: `
+		pipeline_id = "abcd"
+		full_refresh = true
+		refresh_selection = ["sales"]`,
+	}.ExpectError(t, "invalid config supplied. [full_refresh] Conflicting configuration arguments. [refresh_selection] Conflicting configuration arguments")
+}
+
+func TestWaitForUpdate_TimeoutStopsPipeline(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	e := w.GetMockPipelinesAPI().EXPECT()
+	e.GetUpdate(mock.Anything, pipelines.GetUpdateRequest{
+		PipelineId: "abcd",
+		UpdateId:   "u1",
+	}).Return(&pipelines.GetUpdateResponse{
+		Update: &pipelines.UpdateInfo{
+			UpdateId: "u1",
+			State:    pipelines.UpdateInfoStateRunning,
+		},
+	}, nil)
+	e.Stop(mock.Anything, pipelines.StopRequest{
+		PipelineId: "abcd",
+	}).Return(&pipelines.WaitGetPipelineIdle[struct{}]{}, nil).Once()
+
+	state, err := WaitForUpdate(w.WorkspaceClient, context.Background(), "abcd", "u1", 50*time.Millisecond)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pipeline abcd is stopped")
+	assert.Equal(t, pipelines.UpdateInfoStateRunning, state)
+}
+
+func TestWaitForUpdate_ReadErrorDoesNotStopPipeline(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockPipelinesAPI().EXPECT().GetUpdate(mock.Anything, pipelines.GetUpdateRequest{
+		PipelineId: "abcd",
+		UpdateId:   "u1",
+	}).Return(nil, &apierr.APIError{
+		ErrorCode:  "PERMISSION_DENIED",
+		StatusCode: 403,
+		Message:    "no access",
+	})
+
+	_, err := WaitForUpdate(w.WorkspaceClient, context.Background(), "abcd", "u1", time.Minute)
+	assert.EqualError(t, err, "no access")
+}
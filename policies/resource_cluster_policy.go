@@ -49,6 +49,7 @@ func ResourceClusterPolicy() common.Resource {
 	return common.Resource{
 		Schema:        rcpSchema,
 		SchemaVersion: 1,
+		Identity:      common.NewIdentity("policy_id"),
 		StateUpgraders: []schema.StateUpgrader{
 			{
 				Type:    rcpSchemaV0(),
@@ -249,7 +249,8 @@ func ResourceInstancePool() common.Resource {
 		return s
 	})
 	return common.Resource{
-		Schema: s,
+		Schema:   s,
+		Identity: common.NewIdentity("instance_pool_id"),
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var ip InstancePool
 			common.DataToStructPointer(d, s, &ip)
@@ -122,6 +122,8 @@ type ResourceFixture struct {
 	Gcp         bool
 	AccountID   string
 	Token       string
+	// public IP address of the caller, see common.DatabricksClient.SelfIP
+	SelfIP string
 	// new resource
 	New bool
 }
@@ -281,6 +283,7 @@ func (f ResourceFixture) Apply(t *testing.T) (*schema.ResourceData, error) {
 	if f.AccountID != "" {
 		config.AccountID = f.AccountID
 	}
+	client.SetSelfIP(f.SelfIP)
 	f.setDatabricksEnvironmentForTest(client, server.URL)
 	if len(f.HCL) > 0 {
 		var out any
@@ -341,9 +344,12 @@ func (f ResourceFixture) Apply(t *testing.T) (*schema.ResourceData, error) {
 	if execute != nil {
 		// this is a bit strange, but we'll fix it later
 		diags := execute(ctx, resourceData, client)
-		if diags != nil {
+		if diags.HasError() {
 			return resourceData, errors.New(diagsToString(diags))
 		}
+		for _, warning := range diags {
+			log.Printf("[WARN] %s: %s", warning.Summary, warning.Detail)
+		}
 	}
 	if resourceData.Id() == "" && !f.Removed {
 		return resourceData, fmt.Errorf("resource is not expected to be removed")
@@ -22,6 +22,7 @@ func ResourceGitCredential() common.Resource {
 			"GITLAB_TOKEN",               // https://registry.terraform.io/providers/gitlabhq/gitlab/latest/docs
 			"AZDO_PERSONAL_ACCESS_TOKEN", // https://registry.terraform.io/providers/microsoft/azuredevops/latest/docs
 		}, nil)
+		common.AddWriteOnly(s, "personal_access_token")
 		return s
 	})
 
@@ -36,6 +37,7 @@ func ResourceGitCredential() common.Resource {
 
 			var req workspace.CreateCredentialsRequest
 			common.DataToStructPointer(d, s, &req)
+			req.PersonalAccessToken = common.GetWriteOnly(d, "personal_access_token")
 			resp, err := w.GitCredentials.Create(ctx, req)
 
 			if err != nil {
@@ -51,6 +53,7 @@ func ResourceGitCredential() common.Resource {
 				}
 				var req workspace.UpdateCredentialsRequest
 				common.DataToStructPointer(d, s, &req)
+				req.PersonalAccessToken = common.GetWriteOnly(d, "personal_access_token")
 				req.CredentialId = creds[0].CredentialId
 
 				err = w.GitCredentials.Update(ctx, req)
@@ -83,6 +86,7 @@ func ResourceGitCredential() common.Resource {
 			var req workspace.UpdateCredentialsRequest
 
 			common.DataToStructPointer(d, s, &req)
+			req.PersonalAccessToken = common.GetWriteOnly(d, "personal_access_token")
 			cred_id, err := strconv.ParseInt(d.Id(), 10, 64)
 			if err != nil {
 				return err
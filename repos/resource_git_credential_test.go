@@ -368,3 +368,82 @@ func TestGitCredentialCornerCases(t *testing.T) {
 		qa.CornerCaseSkipCRUD("create"),
 		qa.CornerCaseExpectError(`strconv.ParseInt: parsing "x": invalid syntax`))
 }
+
+func TestResourceGitCredentialCreate_WriteOnly(t *testing.T) {
+	provider := "gitHub"
+	user := "test"
+	token := "12345"
+	resp := workspace.CreateCredentialsResponse{
+		CredentialId: 121232342,
+	}
+
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			gmock := w.GetMockGitCredentialsAPI().EXPECT()
+			gmock.Create(mock.Anything, workspace.CreateCredentialsRequest{
+				GitProvider:         provider,
+				GitUsername:         user,
+				PersonalAccessToken: token,
+			}).
+				Return(&resp, nil)
+			gmock.Get(mock.Anything, workspace.GetCredentialsRequest{CredentialId: resp.CredentialId}).
+				Return(&workspace.GetCredentialsResponse{
+					CredentialId: resp.CredentialId,
+					GitProvider:  provider,
+					GitUsername:  user,
+				}, nil)
+		},
+		Resource: ResourceGitCredential(),
+		HCL: `
+		git_provider = "gitHub"
+		git_username = "test"
+		personal_access_token_wo = "12345"
+		personal_access_token_wo_version = 1
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                               fmt.Sprintf("%d", resp.CredentialId),
+		"personal_access_token":            "",
+		"personal_access_token_wo_version": 1,
+	})
+}
+
+func TestResourceGitCredentialUpdate_WriteOnlyVersion(t *testing.T) {
+	credID := int64(121232342)
+	provider := "gitHub"
+	user := "test"
+	token := "67890"
+
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			gmock := w.GetMockGitCredentialsAPI().EXPECT()
+			gmock.Update(mock.Anything, workspace.UpdateCredentialsRequest{
+				CredentialId:        credID,
+				GitProvider:         provider,
+				GitUsername:         user,
+				PersonalAccessToken: token,
+			}).
+				Return(nil)
+			gmock.Get(mock.Anything, workspace.GetCredentialsRequest{CredentialId: credID}).
+				Return(&workspace.GetCredentialsResponse{
+					CredentialId: credID,
+					GitProvider:  provider,
+					GitUsername:  user,
+				}, nil)
+		},
+		Resource: ResourceGitCredential(),
+		InstanceState: map[string]string{
+			"git_provider":                     provider,
+			"git_username":                     user,
+			"personal_access_token_wo_version": "1",
+		},
+		HCL: `
+		git_provider = "gitHub"
+		git_username = "test"
+		personal_access_token_wo = "67890"
+		personal_access_token_wo_version = 2
+		`,
+		ID:     "121232342",
+		Update: true,
+	}.ApplyAndExpectData(t, map[string]any{"personal_access_token_wo_version": 2})
+}
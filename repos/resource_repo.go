@@ -189,6 +189,7 @@ func ResourceRepo() common.Resource {
 	return common.Resource{
 		Schema:        s,
 		SchemaVersion: 1,
+		Identity:      common.NewIdentity("repo_id"),
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			reposAPI := NewReposAPI(ctx, c)
 			var repo ReposInformation
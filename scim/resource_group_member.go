@@ -8,14 +8,22 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 )
 
+const groupMembersBulkReadKind = "group_members"
+
 // ResourceGroupMember bind group with member
 func ResourceGroupMember() common.Resource {
 	return common.NewPairID("group_id", "member_id").BindResource(common.BindResource{
 		CreateContext: func(ctx context.Context, groupID, memberID string, c *common.DatabricksClient) error {
+			defer c.InvalidateBulkRead(groupMembersBulkReadKind, groupID)
 			return NewGroupsAPI(ctx, c).Patch(groupID, PatchRequestWithValue("add", "members", memberID))
 		},
 		ReadContext: func(ctx context.Context, groupID, memberID string, c *common.DatabricksClient) error {
-			group, err := NewGroupsAPI(ctx, c).Read(groupID, "members")
+			// all members of a group are returned at once, so with the bulk read cache enabled
+			// they are fetched once for all databricks_group_member resources of the group
+			group, err := common.BulkRead(ctx, c, groupMembersBulkReadKind, groupID,
+				func(ctx context.Context) (Group, error) {
+					return NewGroupsAPI(ctx, c).Read(groupID, "members")
+				})
 			hasMember := ComplexValues(group.Members).HasValue(memberID)
 			if err == nil && !hasMember {
 				return &apierr.APIError{
@@ -27,6 +35,7 @@ func ResourceGroupMember() common.Resource {
 			return err
 		},
 		DeleteContext: func(ctx context.Context, groupID, memberID string, c *common.DatabricksClient) error {
+			defer c.InvalidateBulkRead(groupMembersBulkReadKind, groupID)
 			return NewGroupsAPI(ctx, c).Patch(groupID, PatchRequest(
 				"remove", fmt.Sprintf(`members[value eq "%s"]`, memberID)))
 		},
@@ -1,11 +1,13 @@
 package scim
 
 import (
+	"context"
 	"testing"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestResourceGroupMemberCreate(t *testing.T) {
@@ -195,3 +197,56 @@ func TestResourceGroupMemberDelete_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Internal error happened")
 	assert.Equal(t, "abc|bcd", d.Id())
 }
+
+func TestResourceGroupMemberRead_BulkReadCache(t *testing.T) {
+	members := func(ids ...string) Group {
+		group := Group{ID: "abc"}
+		for _, id := range ids {
+			group.Members = append(group.Members, ComplexValue{Value: id})
+		}
+		return group
+	}
+	client, server, err := qa.HttpFixtureClient(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Groups/abc?attributes=members",
+			Response: members("bcd", "cde"),
+		},
+		{
+			Method:          "PATCH",
+			Resource:        "/api/2.0/preview/scim/v2/Groups/abc",
+			ExpectedRequest: PatchRequestWithValue("add", "members", "def"),
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Groups/abc?attributes=members",
+			Response: members("bcd", "cde", "def"),
+		},
+	})
+	require.NoError(t, err)
+	defer server.Close()
+	client.EnableBulkReadCache()
+	ctx := context.Background()
+	r := ResourceGroupMember().ToResource()
+	read := func(id string) string {
+		d := r.TestResourceData()
+		d.SetId(id)
+		diags := r.ReadContext(ctx, d, client)
+		require.False(t, diags.HasError(), "%v", diags)
+		return d.Id()
+	}
+	// all members of the group are read with a single call
+	assert.Equal(t, "abc|bcd", read("abc|bcd"))
+	assert.Equal(t, "abc|cde", read("abc|cde"))
+	assert.Equal(t, "", read("abc|def"))
+
+	d := r.TestResourceData()
+	d.Set("group_id", "abc")
+	d.Set("member_id", "def")
+	diags := r.CreateContext(ctx, d, client)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	// create invalidates the cached members of the group
+	assert.Equal(t, "abc|def", read("abc|def"))
+	assert.Equal(t, "abc|bcd", read("abc|bcd"))
+}
@@ -153,7 +153,8 @@ func ResourceServicePrincipal() common.Resource {
 		}
 	}
 	return common.Resource{
-		Schema: servicePrincipalSchema,
+		Schema:   servicePrincipalSchema,
+		Identity: common.NewIdentity("service_principal_id"),
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			sp := spFromData(d)
 			spAPI := NewServicePrincipalsAPI(ctx, c)
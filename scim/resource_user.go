@@ -90,7 +90,8 @@ func ResourceUser() common.Resource {
 		}, nil
 	}
 	return common.Resource{
-		Schema: userSchema,
+		Schema:   userSchema,
+		Identity: common.NewIdentity("user_id"),
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			u, err := scimUserFromData(d)
 			if err != nil {
@@ -67,8 +67,10 @@ func ResourceSecret() common.Resource {
 			Computed: true,
 		},
 	}
+	common.AddWriteOnly(s, "string_value")
 	return common.Resource{
-		Schema: s,
+		Schema:   s,
+		Identity: p.Identity(),
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
@@ -76,6 +78,7 @@ func ResourceSecret() common.Resource {
 			}
 			var putSecretReq workspace.PutSecret
 			common.DataToStructPointer(d, s, &putSecretReq)
+			putSecretReq.StringValue = common.GetWriteOnly(d, "string_value")
 			err = w.Secrets.PutSecret(ctx, putSecretReq)
 			if err != nil {
 				return err
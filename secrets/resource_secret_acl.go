@@ -2,6 +2,10 @@ package secrets
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
 
 	"github.com/databricks/databricks-sdk-go/service/workspace"
 
@@ -10,6 +14,41 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const secretACLsBulkReadKind = "secret_acls"
+
+// readSecretACL returns the permission of the principal on the secret scope. With the bulk read cache
+// enabled, all ACLs of the scope are listed once and shared by all databricks_secret_acl resources.
+func readSecretACL(ctx context.Context, c *common.DatabricksClient, w *databricks.WorkspaceClient,
+	scope, principal string) (workspace.AclPermission, error) {
+	if !c.BulkReadCacheEnabled() {
+		secretACL, err := w.Secrets.GetAcl(ctx, workspace.GetAclRequest{
+			Scope:     scope,
+			Principal: principal,
+		})
+		if err != nil {
+			return "", err
+		}
+		return secretACL.Permission, nil
+	}
+	acls, err := common.BulkRead(ctx, c, secretACLsBulkReadKind, scope,
+		func(ctx context.Context) ([]workspace.AclItem, error) {
+			return w.Secrets.ListAclsAll(ctx, workspace.ListAclsRequest{Scope: scope})
+		})
+	if err != nil {
+		return "", err
+	}
+	for _, acl := range acls {
+		if acl.Principal == principal {
+			return acl.Permission, nil
+		}
+	}
+	return "", &apierr.APIError{
+		ErrorCode:  "NOT_FOUND",
+		StatusCode: 404,
+		Message:    fmt.Sprintf("no ACL for %s found in secret scope %s", principal, scope),
+	}
+}
+
 // ResourceSecretACL manages access to secret scopes
 func ResourceSecretACL() common.Resource {
 	p := common.NewPairSeparatedID("scope", "principal", "|||")
@@ -44,6 +83,7 @@ func ResourceSecretACL() common.Resource {
 			var req workspace.PutAcl
 			common.DataToStructPointer(d, s, &req)
 			err = w.Secrets.PutAcl(ctx, req)
+			c.InvalidateBulkRead(secretACLsBulkReadKind, req.Scope)
 			if err != nil {
 				return err
 			}
@@ -60,14 +100,11 @@ func ResourceSecretACL() common.Resource {
 			if err != nil {
 				return err
 			}
-			secretACL, err := w.Secrets.GetAcl(ctx, workspace.GetAclRequest{
-				Scope:     scope,
-				Principal: principal,
-			})
+			permission, err := readSecretACL(ctx, c, w, scope, principal)
 			if err != nil {
 				return err
 			}
-			return d.Set("permission", secretACL.Permission.String())
+			return d.Set("permission", permission.String())
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			scope, principal, err := p.Unpack(d)
@@ -82,6 +119,7 @@ func ResourceSecretACL() common.Resource {
 				Scope:     scope,
 				Principal: principal,
 			})
+			c.InvalidateBulkRead(secretACLsBulkReadKind, scope)
 			return common.IgnoreNotFoundError(err)
 		},
 	}
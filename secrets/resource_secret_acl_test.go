@@ -1,12 +1,15 @@
 package secrets
 
 import (
+	"context"
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/workspace"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var internalErrorResponse = apierr.APIError{
@@ -212,3 +215,60 @@ func TestResourceSecretACLDelete_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Internal error happened")
 	assert.Equal(t, "global|||something", d.Id())
 }
+
+func TestResourceSecretACLRead_BulkReadCache(t *testing.T) {
+	client, server, err := qa.HttpFixtureClient(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/secrets/acls/list?scope=global",
+			Response: workspace.ListAclsResponse{
+				Items: []workspace.AclItem{
+					{Principal: "users", Permission: "READ"},
+					{Principal: "admins", Permission: "MANAGE"},
+				},
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/secrets/acls/delete",
+			ExpectedRequest: workspace.DeleteAcl{
+				Scope:     "global",
+				Principal: "users",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/secrets/acls/list?scope=global",
+			Response: workspace.ListAclsResponse{
+				Items: []workspace.AclItem{
+					{Principal: "admins", Permission: "MANAGE"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	defer server.Close()
+	client.EnableBulkReadCache()
+	ctx := context.Background()
+	r := ResourceSecretACL().ToResource()
+	read := func(id string) *schema.ResourceData {
+		d := r.TestResourceData()
+		d.SetId(id)
+		diags := r.ReadContext(ctx, d, client)
+		require.False(t, diags.HasError(), "%v", diags)
+		return d
+	}
+	// both ACLs are read with a single list call
+	assert.Equal(t, "READ", read("global|||users").Get("permission"))
+	assert.Equal(t, "MANAGE", read("global|||admins").Get("permission"))
+	assert.Equal(t, "", read("global|||nobody").Id())
+
+	d := r.TestResourceData()
+	d.SetId("global|||users")
+	diags := r.DeleteContext(ctx, d, client)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	// delete invalidates the cached list of the scope
+	assert.Equal(t, "", read("global|||users").Id())
+	assert.Equal(t, "MANAGE", read("global|||admins").Get("permission"))
+}
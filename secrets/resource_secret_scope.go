@@ -67,6 +67,7 @@ func ResourceSecretScope() common.Resource {
 	return common.Resource{
 		Schema:        s,
 		SchemaVersion: 2,
+		Identity:      common.NewIdentity("name"),
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
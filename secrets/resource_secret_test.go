@@ -184,3 +184,54 @@ func TestResourceSecretDelete_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Internal error happened")
 	assert.Equal(t, "foo|||bar", d.Id())
 }
+
+func TestResourceSecretCreate_WriteOnly(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/put",
+				ExpectedRequest: workspace.PutSecret{
+					StringValue: "SparkIsTh3Be$t",
+					Scope:       "foo",
+					Key:         "bar",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/list?scope=foo",
+				Response: workspace.ListSecretsResponse{
+					Secrets: []workspace.SecretMetadata{
+						{
+							Key:                  "bar",
+							LastUpdatedTimestamp: 12345678,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceSecret(),
+		HCL: `
+		scope = "foo"
+		key = "bar"
+		string_value_wo = "SparkIsTh3Be$t"
+		string_value_wo_version = 1
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                      "foo|||bar",
+		"string_value":            "",
+		"string_value_wo_version": 1,
+	})
+}
+
+func TestResourceSecretCreate_NoValue(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSecret(),
+		HCL: `
+		scope = "foo"
+		key = "bar"
+		`,
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. [string_value] Invalid combination of arguments. [string_value_wo] Invalid combination of arguments")
+}
@@ -0,0 +1,28 @@
+package sharing
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+func DataSourceProvider() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *struct {
+		Id           string                `json:"id,omitempty" tf:"computed"`
+		Name         string                `json:"name"`
+		ProviderInfo *sharing.ProviderInfo `json:"provider_info,omitempty" tf:"computed"`
+	}, w *databricks.WorkspaceClient) error {
+		provider, err := w.Providers.GetByName(ctx, data.Name)
+		if err != nil {
+			return err
+		}
+		// the recipient profile contains the bearer token of the provider, so it must not be exposed
+		provider.RecipientProfile = nil
+		provider.RecipientProfileStr = ""
+		data.ProviderInfo = provider
+		data.Id = provider.Name
+		return nil
+	})
+}
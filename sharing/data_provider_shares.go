@@ -0,0 +1,88 @@
+package sharing
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+type ProviderShareObject struct {
+	Name           string `json:"name"`
+	DataObjectType string `json:"data_object_type"`
+	Schema         string `json:"schema,omitempty"`
+	Comment        string `json:"comment,omitempty"`
+}
+
+type ProviderShareDetail struct {
+	Name    string                `json:"name"`
+	Objects []ProviderShareObject `json:"objects,omitempty" tf:"alias:object"`
+}
+
+// providerShareObjects lists tables, volumes, notebooks and functions shared in a share of a provider.
+func providerShareObjects(ctx context.Context, w *databricks.WorkspaceClient, providerName, shareName string) ([]ProviderShareObject, error) {
+	assets, err := w.Providers.ListProviderShareAssetsByProviderNameAndShareName(ctx, providerName, shareName)
+	if err != nil {
+		return nil, err
+	}
+	objects := []ProviderShareObject{}
+	for _, table := range assets.Tables {
+		objects = append(objects, ProviderShareObject{
+			Name:           table.Name,
+			DataObjectType: "TABLE",
+			Schema:         table.Schema,
+			Comment:        table.Comment,
+		})
+	}
+	for _, volume := range assets.Volumes {
+		objects = append(objects, ProviderShareObject{
+			Name:           volume.Name,
+			DataObjectType: "VOLUME",
+			Schema:         volume.Schema,
+			Comment:        volume.Comment,
+		})
+	}
+	for _, notebook := range assets.Notebooks {
+		objects = append(objects, ProviderShareObject{
+			Name:           notebook.Name,
+			DataObjectType: "NOTEBOOK_FILE",
+			Comment:        notebook.Comment,
+		})
+	}
+	for _, function := range assets.Functions {
+		objects = append(objects, ProviderShareObject{
+			Name:           function.Name,
+			DataObjectType: "FUNCTION",
+			Schema:         function.Schema,
+			Comment:        function.Comment,
+		})
+	}
+	return objects, nil
+}
+
+func DataSourceProviderShares() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *struct {
+		ProviderName   string                `json:"provider_name"`
+		IncludeObjects bool                  `json:"include_objects,omitempty"`
+		Shares         []ProviderShareDetail `json:"shares,omitempty" tf:"computed"`
+	}, w *databricks.WorkspaceClient) error {
+		shares, err := w.Providers.ListSharesAll(ctx, sharing.ListSharesRequest{
+			Name: data.ProviderName,
+		})
+		if err != nil {
+			return err
+		}
+		for _, share := range shares {
+			detail := ProviderShareDetail{Name: share.Name}
+			if data.IncludeObjects {
+				detail.Objects, err = providerShareObjects(ctx, w, data.ProviderName, share.Name)
+				if err != nil {
+					return err
+				}
+			}
+			data.Shares = append(data.Shares, detail)
+		}
+		return nil
+	})
+}
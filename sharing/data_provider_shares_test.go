@@ -0,0 +1,81 @@
+package sharing
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProviderSharesData(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(m *mocks.MockWorkspaceClient) {
+			e := m.GetMockProvidersAPI().EXPECT()
+			e.ListSharesAll(mock.Anything, sharing.ListSharesRequest{Name: "partner"}).Return([]sharing.ProviderShare{
+				{Name: "sales"},
+			}, nil)
+			e.ListProviderShareAssetsByProviderNameAndShareName(mock.Anything, "partner", "sales").Return(
+				&sharing.ListProviderShareAssetsResponse{
+					Tables: []sharing.Table{
+						{Name: "orders", Schema: "default", Comment: "all orders"},
+					},
+					Volumes: []sharing.Volume{
+						{Name: "raw", Schema: "landing"},
+					},
+					Notebooks: []sharing.NotebookFile{
+						{Name: "README"},
+					},
+				}, nil)
+		},
+		Resource: DataSourceProviderShares(),
+		HCL: `
+		provider_name   = "partner"
+		include_objects = true`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"shares.#":                           1,
+		"shares.0.name":                      "sales",
+		"shares.0.object.#":                  3,
+		"shares.0.object.0.name":             "orders",
+		"shares.0.object.0.data_object_type": "TABLE",
+		"shares.0.object.0.schema":           "default",
+		"shares.0.object.0.comment":          "all orders",
+		"shares.0.object.1.data_object_type": "VOLUME",
+		"shares.0.object.2.data_object_type": "NOTEBOOK_FILE",
+	})
+}
+
+func TestProviderSharesData_WithoutObjects(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(m *mocks.MockWorkspaceClient) {
+			m.GetMockProvidersAPI().EXPECT().ListSharesAll(mock.Anything, sharing.ListSharesRequest{Name: "partner"}).Return(
+				[]sharing.ProviderShare{{Name: "sales"}, {Name: "marketing"}}, nil)
+		},
+		Resource: DataSourceProviderShares(),
+		HCL: `
+		provider_name = "partner"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"shares.#":          2,
+		"shares.0.name":     "sales",
+		"shares.0.object.#": 0,
+		"shares.1.name":     "marketing",
+	})
+}
+
+func TestProviderSharesData_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataSourceProviderShares(),
+		HCL:         `provider_name = "partner"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
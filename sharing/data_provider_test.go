@@ -0,0 +1,50 @@
+package sharing
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProviderData(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(m *mocks.MockWorkspaceClient) {
+			m.GetMockProvidersAPI().EXPECT().GetByName(mock.Anything, "partner").Return(&sharing.ProviderInfo{
+				Name:                          "partner",
+				AuthenticationType:            sharing.AuthenticationTypeDatabricks,
+				DataProviderGlobalMetastoreId: "aws:us-west-2:abc",
+				RecipientProfileStr:           "secret",
+				RecipientProfile: &sharing.RecipientProfile{
+					BearerToken: "secret",
+				},
+			}, nil)
+		},
+		Resource: DataSourceProvider(),
+		HCL: `
+		name = "partner"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                                  "partner",
+		"provider_info.0.name":                "partner",
+		"provider_info.0.authentication_type": "DATABRICKS",
+		"provider_info.0.data_provider_global_metastore_id": "aws:us-west-2:abc",
+		"provider_info.0.recipient_profile_str":             "",
+		"provider_info.0.recipient_profile.#":               0,
+	})
+}
+
+func TestProviderData_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataSourceProvider(),
+		HCL:         `name = "partner"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
@@ -0,0 +1,31 @@
+package sharing
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+func DataSourceProviders() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *struct {
+		DataProviderGlobalMetastoreId string   `json:"data_provider_global_metastore_id,omitempty"`
+		AuthenticationType            string   `json:"authentication_type,omitempty"`
+		Providers                     []string `json:"providers,omitempty" tf:"computed,slice_set"`
+	}, w *databricks.WorkspaceClient) error {
+		providers, err := w.Providers.ListAll(ctx, sharing.ListProvidersRequest{
+			DataProviderGlobalMetastoreId: data.DataProviderGlobalMetastoreId,
+		})
+		if err != nil {
+			return err
+		}
+		for _, provider := range providers {
+			if data.AuthenticationType != "" && string(provider.AuthenticationType) != data.AuthenticationType {
+				continue
+			}
+			data.Providers = append(data.Providers, provider.Name)
+		}
+		return nil
+	})
+}
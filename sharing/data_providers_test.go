@@ -0,0 +1,40 @@
+package sharing
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProvidersData(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(m *mocks.MockWorkspaceClient) {
+			m.GetMockProvidersAPI().EXPECT().ListAll(mock.Anything, sharing.ListProvidersRequest{}).Return([]sharing.ProviderInfo{
+				{Name: "a", AuthenticationType: sharing.AuthenticationTypeDatabricks},
+				{Name: "b", AuthenticationType: sharing.AuthenticationTypeToken},
+				{Name: "c", AuthenticationType: sharing.AuthenticationTypeDatabricks},
+			}, nil)
+		},
+		Resource: DataSourceProviders(),
+		HCL: `
+		authentication_type = "DATABRICKS"`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"providers": []any{"a", "c"},
+	})
+}
+
+func TestProvidersData_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataSourceProviders(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
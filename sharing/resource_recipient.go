@@ -2,9 +2,11 @@ package sharing
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
 
+	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/sharing"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -42,6 +44,24 @@ func ResourceRecipient() common.Resource {
 		for _, path := range []string{"id", "created_at", "created_by", "activation_url", "expiration_time", "updated_at", "updated_by"} {
 			common.CustomizeSchemaPath(s, "tokens", path).SetReadOnly()
 		}
+		common.CustomizeSchemaPath(s, "activation_url").SetSensitive()
+		common.CustomizeSchemaPath(s, "tokens", "activation_url").SetSensitive()
+
+		// token rotation
+		s["rotation_trigger"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
+		s["existing_token_expire_in_seconds"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		}
+		s["token_expiration_time"] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		}
 
 		return s
 	})
@@ -78,7 +98,16 @@ func ResourceRecipient() common.Resource {
 					}
 				}
 			}
-			return common.StructToData(ri, recipientSchema, d)
+			if ri.ActivationUrl == "" {
+				// activation URL is returned only until the recipient is activated,
+				// so we keep the one that was retrieved before.
+				ri.ActivationUrl = d.Get("activation_url").(string)
+			}
+			err = common.StructToData(ri, recipientSchema, d)
+			if err != nil {
+				return err
+			}
+			return d.Set("token_expiration_time", latestToken(ri.Tokens).ExpirationTime)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
@@ -99,25 +128,14 @@ func ResourceRecipient() common.Resource {
 				}
 			}
 
-			if !d.HasChangeExcept("owner") {
-				return nil
-			}
-
-			updateRecipientRequest.Owner = ""
-			_, err = w.Recipients.Update(ctx, updateRecipientRequest)
-			if err != nil {
-				if d.HasChange("owner") {
-					// Rollback
-					old, new := d.GetChange("owner")
-					_, rollbackErr := w.Recipients.Update(ctx, sharing.UpdateRecipient{
-						Name:  updateRecipientRequest.Name,
-						Owner: old.(string),
-					})
-					if rollbackErr != nil {
-						return common.OwnerRollbackError(err, rollbackErr, old.(string), new.(string))
-					}
+			if d.HasChangesExcept("owner", "rotation_trigger", "existing_token_expire_in_seconds") {
+				err = updateRecipient(ctx, w, d, updateRecipientRequest)
+				if err != nil {
+					return err
 				}
-				return err
+			}
+			if d.HasChange("rotation_trigger") {
+				return rotateRecipientToken(ctx, w, d)
 			}
 			return nil
 		},
@@ -130,3 +148,56 @@ func ResourceRecipient() common.Resource {
 		},
 	}
 }
+
+func updateRecipient(ctx context.Context, w *databricks.WorkspaceClient, d *schema.ResourceData,
+	updateRecipientRequest sharing.UpdateRecipient) error {
+
+	updateRecipientRequest.Owner = ""
+	_, err := w.Recipients.Update(ctx, updateRecipientRequest)
+	if err != nil {
+		if d.HasChange("owner") {
+			// Rollback
+			old, new := d.GetChange("owner")
+			_, rollbackErr := w.Recipients.Update(ctx, sharing.UpdateRecipient{
+				Name:  updateRecipientRequest.Name,
+				Owner: old.(string),
+			})
+			if rollbackErr != nil {
+				return common.OwnerRollbackError(err, rollbackErr, old.(string), new.(string))
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// rotateRecipientToken issues a new token for a recipient with TOKEN authentication type. The existing token
+// expires after the configured amount of seconds, so that the recipient has time to pick up the new one.
+func rotateRecipientToken(ctx context.Context, w *databricks.WorkspaceClient, d *schema.ResourceData) error {
+	if d.Get("authentication_type").(string) != string(sharing.AuthenticationTypeToken) {
+		return fmt.Errorf("token rotation is only supported for recipients with TOKEN authentication type")
+	}
+	ri, err := w.Recipients.RotateToken(ctx, sharing.RotateRecipientToken{
+		Name:                         d.Id(),
+		ExistingTokenExpireInSeconds: int64(d.Get("existing_token_expire_in_seconds").(int)),
+	})
+	if err != nil {
+		return err
+	}
+	activationUrl := ri.ActivationUrl
+	if activationUrl == "" {
+		activationUrl = latestToken(ri.Tokens).ActivationUrl
+	}
+	return d.Set("activation_url", activationUrl)
+}
+
+// latestToken returns the most recently created token of a recipient.
+func latestToken(tokens []sharing.RecipientTokenInfo) sharing.RecipientTokenInfo {
+	var latest sharing.RecipientTokenInfo
+	for _, token := range tokens {
+		if token.CreatedAt >= latest.CreatedAt {
+			latest = token
+		}
+	}
+	return latest
+}
@@ -380,6 +380,115 @@ func TestUpdateRecipientRollback(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Something unexpected")
 }
 
+func TestReadRecipientKeepsActivationUrl(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/recipients/a?",
+				Response: sharing.RecipientInfo{
+					Name:               "a",
+					AuthenticationType: "TOKEN",
+					Activated:          true,
+					Tokens: []sharing.RecipientTokenInfo{
+						{Id: "t1", CreatedAt: 1, ExpirationTime: 100},
+						{Id: "t2", CreatedAt: 2, ExpirationTime: 200},
+					},
+				},
+			},
+		},
+		Resource: ResourceRecipient(),
+		Read:     true,
+		ID:       "a",
+		InstanceState: map[string]string{
+			"name":                "a",
+			"authentication_type": "TOKEN",
+			"activation_url":      "https://activate/t2",
+		},
+		HCL: `
+		name = "a"
+		authentication_type = "TOKEN"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"activated":             true,
+		"activation_url":        "https://activate/t2",
+		"token_expiration_time": 200,
+	})
+}
+
+func TestUpdateRecipientRotateToken(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.1/unity-catalog/recipients/a/rotate-token",
+				ExpectedRequest: sharing.RotateRecipientToken{
+					ExistingTokenExpireInSeconds: 3600,
+				},
+				Response: sharing.RecipientInfo{
+					Name:               "a",
+					AuthenticationType: "TOKEN",
+					Tokens: []sharing.RecipientTokenInfo{
+						{Id: "t1", CreatedAt: 1, ExpirationTime: 100, ActivationUrl: "https://activate/t1"},
+						{Id: "t2", CreatedAt: 2, ExpirationTime: 200, ActivationUrl: "https://activate/t2"},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/recipients/a?",
+				Response: sharing.RecipientInfo{
+					Name:               "a",
+					AuthenticationType: "TOKEN",
+					Tokens: []sharing.RecipientTokenInfo{
+						{Id: "t1", CreatedAt: 1, ExpirationTime: 100},
+						{Id: "t2", CreatedAt: 2, ExpirationTime: 200},
+					},
+				},
+			},
+		},
+		Resource: ResourceRecipient(),
+		Update:   true,
+		ID:       "a",
+		InstanceState: map[string]string{
+			"name":                             "a",
+			"authentication_type":              "TOKEN",
+			"rotation_trigger":                 "2025-01-01",
+			"existing_token_expire_in_seconds": "3600",
+		},
+		HCL: `
+		name = "a"
+		authentication_type = "TOKEN"
+		rotation_trigger = "2025-04-01"
+		existing_token_expire_in_seconds = 3600
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"rotation_trigger":      "2025-04-01",
+		"activation_url":        "https://activate/t2",
+		"token_expiration_time": 200,
+	})
+}
+
+func TestUpdateRecipientRotateTokenNotTokenAuth(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceRecipient(),
+		Update:   true,
+		ID:       "a",
+		InstanceState: map[string]string{
+			"name":                               "a",
+			"authentication_type":                "DATABRICKS",
+			"data_recipient_global_metastore_id": "aws:us-west-2:abc",
+			"rotation_trigger":                   "1",
+		},
+		HCL: `
+		name = "a"
+		authentication_type = "DATABRICKS"
+		data_recipient_global_metastore_id = "aws:us-west-2:abc"
+		rotation_trigger = "2"
+		`,
+	}.ExpectError(t, "token rotation is only supported for recipients with TOKEN authentication type")
+}
+
 func TestDeleteRecipient(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -150,7 +150,8 @@ func ResourceSqlEndpoint() common.Resource {
 			}
 			return w.Warehouses.DeleteById(ctx, d.Id())
 		},
-		Schema: s,
+		Schema:   s,
+		Identity: common.NewIdentity("warehouse_id"),
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
 			return d.Clear("health")
 		},
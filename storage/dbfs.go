@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"strings"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 )
@@ -44,6 +45,19 @@ type ReadResponse struct {
 	Data      string `json:"data"`
 }
 
+// LocalPath converts `dbfs:` URI into the path on the local filesystem of a cluster: `/Volumes/...` for paths
+// within Unity Catalog volumes and `/dbfs/...` for everything else. Other paths are returned as is.
+func LocalPath(path string) string {
+	if !strings.HasPrefix(path, "dbfs:") {
+		return path
+	}
+	p := "/" + strings.TrimLeft(strings.TrimPrefix(path, "dbfs:"), "/")
+	if p == "/Volumes" || strings.HasPrefix(p, "/Volumes/") {
+		return p
+	}
+	return "/dbfs" + p
+}
+
 // NewDbfsAPI creates DBFSAPI instance from provider meta
 func NewDbfsAPI(ctx context.Context, m any) DbfsAPI {
 	return DbfsAPI{m.(*common.DatabricksClient), ctx}
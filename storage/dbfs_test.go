@@ -163,3 +163,11 @@ func TestDbfsReadFails(t *testing.T) {
 		assert.EqualError(t, err, "cannot read abc: fails")
 	})
 }
+
+func TestLocalPath(t *testing.T) {
+	assert.Equal(t, "/dbfs/FileStore/init.sh", LocalPath("dbfs:/FileStore/init.sh"))
+	assert.Equal(t, "/dbfs/", LocalPath("dbfs:/"))
+	assert.Equal(t, "/Volumes/main/default/vol/a.sh", LocalPath("dbfs:/Volumes/main/default/vol/a.sh"))
+	assert.Equal(t, "/dbfs/Volumesque", LocalPath("dbfs:/Volumesque"))
+	assert.Equal(t, "/Workspace/init.sh", LocalPath("/Workspace/init.sh"))
+}
@@ -9,20 +9,167 @@ import (
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/oauth2"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
 )
 
+// defaultMaxActiveSecrets is the number of secrets kept alive by rotation if `max_active_secrets` isn't set
+const defaultMaxActiveSecrets = 2
+
+type ServicePrincipalSecretStorage struct {
+	WorkspaceId int64  `json:"workspace_id"`
+	Scope       string `json:"scope"`
+	Key         string `json:"key"`
+}
+
+type PreviousServicePrincipalSecret struct {
+	Id        string `json:"id,omitempty" tf:"computed"`
+	Secret    string `json:"secret,omitempty" tf:"computed,sensitive"`
+	RotatedAt string `json:"rotated_at,omitempty" tf:"computed"`
+}
+
 type ServicePrincipalSecret struct {
 	oauth2.CreateServicePrincipalSecretResponse
-	ServicePrincipalId string `json:"service_principal_id" tf:"force_new"`
-	Lifetime           string `json:"lifetime,omitempty" tf:"computed,force_new"`
+	ServicePrincipalId string                           `json:"service_principal_id" tf:"force_new"`
+	Lifetime           string                           `json:"lifetime,omitempty" tf:"computed,force_new"`
+	RotationTrigger    string                           `json:"rotation_trigger,omitempty"`
+	MaxActiveSecrets   int                              `json:"max_active_secrets,omitempty"`
+	OverlapPeriod      string                           `json:"overlap_period,omitempty"`
+	StoreSecret        *ServicePrincipalSecretStorage   `json:"store_secret,omitempty"`
+	CurrentSecret      string                           `json:"current_secret,omitempty" tf:"computed,sensitive"`
+	PreviousSecret     string                           `json:"previous_secret,omitempty" tf:"computed,sensitive"`
+	PreviousSecrets    []PreviousServicePrincipalSecret `json:"previous_secrets,omitempty" tf:"computed"`
 }
 
 func createFailedToConvertServicePrincipalIdToNumericError(err error) error {
 	return fmt.Errorf("failed to convert service principal ID to numeric: %w", err)
 }
 
+func validateOverlapPeriod(i any, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if _, err := time.ParseDuration(v); err != nil {
+		return nil, []error{fmt.Errorf("%s must be a duration, like 24h: %w", k, err)}
+	}
+	return nil, nil
+}
+
+func createServicePrincipalSecret(ctx context.Context, ac *databricks.AccountClient,
+	d *schema.ResourceData) (*oauth2.CreateServicePrincipalSecretResponse, error) {
+	spId := d.Get("service_principal_id").(string)
+	spIdNumeric, err := strconv.ParseInt(spId, 10, 64)
+	if err != nil {
+		return nil, createFailedToConvertServicePrincipalIdToNumericError(err)
+	}
+	return ac.ServicePrincipalSecrets.Create(ctx, oauth2.CreateServicePrincipalSecretRequest{
+		ServicePrincipalId: strconv.FormatInt(spIdNumeric, 10),
+		Lifetime:           d.Get("lifetime").(string),
+	})
+}
+
+func deleteServicePrincipalSecret(ctx context.Context, ac *databricks.AccountClient, spId, secretId string) error {
+	spIdNumeric, err := strconv.ParseInt(spId, 10, 64)
+	if err != nil {
+		return createFailedToConvertServicePrincipalIdToNumericError(err)
+	}
+	err = ac.ServicePrincipalSecrets.Delete(ctx, oauth2.DeleteServicePrincipalSecretRequest{
+		SecretId:           secretId,
+		ServicePrincipalId: strconv.FormatInt(spIdNumeric, 10),
+	})
+	return common.IgnoreNotFoundError(err)
+}
+
+// storeServicePrincipalSecret writes the active secret into the configured secret scope
+func storeServicePrincipalSecret(ctx context.Context, w *databricks.WorkspaceClient,
+	storage ServicePrincipalSecretStorage, secret string) error {
+	return w.Secrets.PutSecret(ctx, workspace.PutSecret{
+		Scope:       storage.Scope,
+		Key:         storage.Key,
+		StringValue: secret,
+	})
+}
+
+func storeServicePrincipalSecretIfConfigured(ctx context.Context, c *common.DatabricksClient,
+	spnSecretSchema map[string]*schema.Schema, d *schema.ResourceData) error {
+	var spSecret ServicePrincipalSecret
+	common.DataToStructPointer(d, spnSecretSchema, &spSecret)
+	if spSecret.StoreSecret == nil {
+		return nil
+	}
+	w, err := c.WorkspaceClientForWorkspace(ctx, spSecret.StoreSecret.WorkspaceId)
+	if err != nil {
+		return err
+	}
+	return storeServicePrincipalSecret(ctx, w, *spSecret.StoreSecret, d.Get("secret").(string))
+}
+
+// secretsToRevoke returns indexes of previous secrets (ordered from the newest) that must be revoked,
+// either because there are more than maxActive secrets, or because their overlap period is over
+func secretsToRevoke(previous []PreviousServicePrincipalSecret, maxActive int, overlapPeriod string, now time.Time) []int {
+	if maxActive == 0 {
+		maxActive = defaultMaxActiveSecrets
+	}
+	overlap, err := time.ParseDuration(overlapPeriod)
+	if overlapPeriod == "" || err != nil {
+		overlap = -1
+	}
+	result := []int{}
+	for i, p := range previous {
+		// the current secret is one of the active secrets
+		if i+1 >= maxActive {
+			result = append(result, i)
+			continue
+		}
+		rotatedAt, err := time.Parse(time.RFC3339, p.RotatedAt)
+		if overlap >= 0 && err == nil && !now.Before(rotatedAt.Add(overlap)) {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+// priorPreviousSecrets returns previous secrets from the state, as they could be marked as computed in the plan
+func priorPreviousSecrets(d *schema.ResourceData) []PreviousServicePrincipalSecret {
+	old, _ := d.GetChange("previous_secrets")
+	previous := []PreviousServicePrincipalSecret{}
+	for _, v := range old.([]any) {
+		m, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		previous = append(previous, PreviousServicePrincipalSecret{
+			Id:        m["id"].(string),
+			Secret:    m["secret"].(string),
+			RotatedAt: m["rotated_at"].(string),
+		})
+	}
+	return previous
+}
+
+func setPreviousSecrets(d *schema.ResourceData, previous []PreviousServicePrincipalSecret) error {
+	list := make([]any, 0, len(previous))
+	for _, p := range previous {
+		list = append(list, map[string]any{
+			"id":         p.Id,
+			"secret":     p.Secret,
+			"rotated_at": p.RotatedAt,
+		})
+	}
+	if err := d.Set("previous_secrets", list); err != nil {
+		return err
+	}
+	previousSecret := ""
+	if len(previous) > 0 {
+		previousSecret = previous[0].Secret
+	}
+	return d.Set("previous_secret", previousSecret)
+}
+
 func ResourceServicePrincipalSecret() common.Resource {
 	spnSecretSchema := common.StructToSchema(ServicePrincipalSecret{},
 		func(m map[string]*schema.Schema) map[string]*schema.Schema {
@@ -39,28 +186,51 @@ func ResourceServicePrincipalSecret() common.Resource {
 			m["secret"].Computed = true
 			m["secret"].Sensitive = true
 			m["status"].Computed = true
+			m["max_active_secrets"].ValidateFunc = validation.IntBetween(1, 5)
+			m["overlap_period"].ValidateFunc = validateOverlapPeriod
 			return m
 		})
+	// attributes that change when the secret is rotated
+	rotatedAttributes := []string{"id", "secret", "secret_hash", "current_secret", "create_time", "update_time",
+		"expire_time", "status", "previous_secret", "previous_secrets"}
 	return common.Resource{
 		Schema: spnSecretSchema,
 		CanSkipReadAfterCreateAndUpdate: func(d *schema.ResourceData) bool {
 			return true
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			if d.Id() == "" {
+				return nil
+			}
+			if d.HasChange("rotation_trigger") {
+				for _, attr := range rotatedAttributes {
+					if err := d.SetNewComputed(attr); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			// plan revocation of previous secrets when their overlap period is over
+			var spSecret ServicePrincipalSecret
+			common.DiffToStructPointer(d, spnSecretSchema, &spSecret)
+			if len(secretsToRevoke(spSecret.PreviousSecrets, spSecret.MaxActiveSecrets,
+				spSecret.OverlapPeriod, time.Now())) > 0 {
+				for _, attr := range []string{"previous_secret", "previous_secrets"} {
+					if err := d.SetNewComputed(attr); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			ac, err := c.AccountClient()
 			if err != nil {
 				return err
 			}
 			spId := d.Get("service_principal_id").(string)
-			spIdNumeric, err := strconv.ParseInt(spId, 10, 64)
-			if err != nil {
-				return createFailedToConvertServicePrincipalIdToNumericError(err)
-			}
 			lifetime := d.Get("lifetime").(string)
-			res, err := ac.ServicePrincipalSecrets.Create(ctx, oauth2.CreateServicePrincipalSecretRequest{
-				ServicePrincipalId: strconv.FormatInt(spIdNumeric, 10),
-				Lifetime:           lifetime,
-			})
+			res, err := createServicePrincipalSecret(ctx, ac, d)
 			if err != nil {
 				return err
 			}
@@ -71,8 +241,9 @@ func ResourceServicePrincipalSecret() common.Resource {
 			}
 			d.Set("lifetime", lifetime)
 			d.Set("service_principal_id", spId)
+			d.Set("current_secret", res.Secret)
 			d.SetId(res.Id)
-			return nil
+			return storeServicePrincipalSecretIfConfigured(ctx, c, spnSecretSchema, d)
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			ac, err := c.AccountClient()
@@ -109,28 +280,101 @@ func ResourceServicePrincipalSecret() common.Resource {
 				d.Set("secret", secret)
 				d.Set("lifetime", lifetime)
 				d.Set("service_principal_id", spId)
-				return nil
+				// forget previous secrets that were revoked or expired outside of Terraform
+				var spSecret ServicePrincipalSecret
+				common.DataToStructPointer(d, spnSecretSchema, &spSecret)
+				if len(spSecret.PreviousSecrets) == 0 {
+					return nil
+				}
+				existing := map[string]struct{}{}
+				for _, s := range secrets {
+					existing[s.Id] = struct{}{}
+				}
+				previous := []PreviousServicePrincipalSecret{}
+				for _, p := range spSecret.PreviousSecrets {
+					if _, ok := existing[p.Id]; ok {
+						previous = append(previous, p)
+					}
+				}
+				return setPreviousSecrets(d, previous)
 			}
 			// recreate it if not found
 			log.Printf("[INFO] service principal secret with id %s not found, recreating it", d.Id())
 			d.SetId("")
 			return nil
 		},
-		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			ac, err := c.AccountClient()
 			if err != nil {
 				return err
 			}
 			spId := d.Get("service_principal_id").(string)
-			spIdNumeric, err := strconv.ParseInt(spId, 10, 64)
+			var spSecret ServicePrincipalSecret
+			common.DataToStructPointer(d, spnSecretSchema, &spSecret)
+			previous := priorPreviousSecrets(d)
+			rotated := d.HasChange("rotation_trigger")
+			if rotated {
+				// the new secret is created before any of the existing secrets is revoked
+				res, err := createServicePrincipalSecret(ctx, ac, d)
+				if err != nil {
+					return err
+				}
+				currentSecret, _ := d.GetChange("secret")
+				previous = append([]PreviousServicePrincipalSecret{{
+					Id:        d.Id(),
+					Secret:    currentSecret.(string),
+					RotatedAt: time.Now().UTC().Format(time.RFC3339),
+				}}, previous...)
+				err = common.StructToData(*res, spnSecretSchema, d)
+				if err != nil {
+					return err
+				}
+				d.Set("current_secret", res.Secret)
+				d.SetId(res.Id)
+				if err = setPreviousSecrets(d, previous); err != nil {
+					return err
+				}
+			}
+			if rotated || d.HasChange("store_secret") {
+				err = storeServicePrincipalSecretIfConfigured(ctx, c, spnSecretSchema, d)
+				if err != nil {
+					return err
+				}
+			}
+			revoke := secretsToRevoke(previous, spSecret.MaxActiveSecrets, spSecret.OverlapPeriod, time.Now())
+			if len(revoke) == 0 {
+				return nil
+			}
+			revoked := map[int]struct{}{}
+			for _, i := range revoke {
+				log.Printf("[INFO] revoking previous service principal secret with id %s", previous[i].Id)
+				err = deleteServicePrincipalSecret(ctx, ac, spId, previous[i].Id)
+				if err != nil {
+					return err
+				}
+				revoked[i] = struct{}{}
+			}
+			remaining := []PreviousServicePrincipalSecret{}
+			for i, p := range previous {
+				if _, ok := revoked[i]; !ok {
+					remaining = append(remaining, p)
+				}
+			}
+			return setPreviousSecrets(d, remaining)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			ac, err := c.AccountClient()
 			if err != nil {
-				return fmt.Errorf("failed to convert service principal ID to numeric: %w", err)
+				return err
 			}
-			err = ac.ServicePrincipalSecrets.Delete(ctx, oauth2.DeleteServicePrincipalSecretRequest{
-				SecretId:           d.Id(),
-				ServicePrincipalId: strconv.FormatInt(spIdNumeric, 10),
-			})
-			return common.IgnoreNotFoundError(err)
+			spId := d.Get("service_principal_id").(string)
+			for _, p := range priorPreviousSecrets(d) {
+				err = deleteServicePrincipalSecret(ctx, ac, spId, p.Id)
+				if err != nil {
+					return err
+				}
+			}
+			return deleteServicePrincipalSecret(ctx, ac, spId, d.Id())
 		},
 	}
 }
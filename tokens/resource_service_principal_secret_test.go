@@ -1,12 +1,17 @@
 package tokens
 
 import (
+	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/databricks/databricks-sdk-go/experimental/mocks"
 	"github.com/databricks/databricks-sdk-go/service/oauth2"
+	"github.com/databricks/databricks-sdk-go/service/provisioning"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -120,3 +125,168 @@ func TestServicePrincipalSecretReadRemoved(t *testing.T) {
 		`,
 	}.ApplyNoError(t)
 }
+
+func TestServicePrincipalSecretRotate(t *testing.T) {
+	rotatedAt := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			e := a.GetMockServicePrincipalSecretsAPI().EXPECT()
+			e.Create(mock.Anything, oauth2.CreateServicePrincipalSecretRequest{
+				ServicePrincipalId: "123",
+			}).Return(&oauth2.CreateServicePrincipalSecretResponse{
+				Secret: "new",
+				Id:     "005",
+				Status: "ACTIVE",
+			}, nil)
+			// the oldest secret is revoked as only 2 secrets are kept alive
+			e.Delete(mock.Anything, oauth2.DeleteServicePrincipalSecretRequest{
+				ServicePrincipalId: "123",
+				SecretId:           "003",
+			}).Return(nil)
+		},
+		Resource:  ResourceServicePrincipalSecret(),
+		ID:        "004",
+		Update:    true,
+		AccountID: "xyz",
+		InstanceState: map[string]string{
+			"service_principal_id":          "123",
+			"rotation_trigger":              "1",
+			"secret":                        "current",
+			"current_secret":                "current",
+			"previous_secret":               "old",
+			"previous_secrets.#":            "1",
+			"previous_secrets.0.id":         "003",
+			"previous_secrets.0.secret":     "old",
+			"previous_secrets.0.rotated_at": rotatedAt,
+		},
+		HCL: `
+		service_principal_id = "123"
+		rotation_trigger     = "2"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                    "005",
+		"secret":                "new",
+		"current_secret":        "new",
+		"previous_secret":       "current",
+		"previous_secrets.#":    1,
+		"previous_secrets.0.id": "004",
+	})
+}
+
+func TestServicePrincipalSecretRevokeAfterOverlapPeriod(t *testing.T) {
+	rotatedAt := time.Now().Add(-25 * time.Hour).UTC().Format(time.RFC3339)
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockServicePrincipalSecretsAPI().EXPECT().Delete(mock.Anything, oauth2.DeleteServicePrincipalSecretRequest{
+				ServicePrincipalId: "123",
+				SecretId:           "003",
+			}).Return(nil)
+		},
+		Resource:  ResourceServicePrincipalSecret(),
+		ID:        "004",
+		Update:    true,
+		AccountID: "xyz",
+		InstanceState: map[string]string{
+			"service_principal_id":          "123",
+			"rotation_trigger":              "1",
+			"overlap_period":                "24h",
+			"secret":                        "current",
+			"current_secret":                "current",
+			"previous_secret":               "old",
+			"previous_secrets.#":            "1",
+			"previous_secrets.0.id":         "003",
+			"previous_secrets.0.secret":     "old",
+			"previous_secrets.0.rotated_at": rotatedAt,
+		},
+		HCL: `
+		service_principal_id = "123"
+		rotation_trigger     = "1"
+		overlap_period       = "24h"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                 "004",
+		"previous_secret":    "",
+		"previous_secrets.#": 0,
+	})
+}
+
+func TestServicePrincipalSecretCreateAndStore(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockServicePrincipalSecretsAPI().EXPECT().Create(mock.Anything, oauth2.CreateServicePrincipalSecretRequest{
+				ServicePrincipalId: "123",
+			}).Return(&oauth2.CreateServicePrincipalSecretResponse{
+				Secret: "qwe",
+				Id:     "003",
+			}, nil)
+			a.GetMockWorkspacesAPI().EXPECT().Get(mock.Anything, provisioning.GetWorkspaceRequest{
+				WorkspaceId: 456,
+			}).Return(nil, fmt.Errorf("workspace not found"))
+		},
+		Resource:  ResourceServicePrincipalSecret(),
+		Create:    true,
+		AccountID: "xyz",
+		HCL: `
+		service_principal_id = "123"
+		store_secret {
+			workspace_id = 456
+			scope        = "sp"
+			key          = "client_secret"
+		}
+		`,
+	}.ExpectError(t, "workspace not found")
+}
+
+func TestStoreServicePrincipalSecret(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockSecretsAPI().EXPECT().PutSecret(mock.Anything, workspace.PutSecret{
+		Scope:       "sp",
+		Key:         "client_secret",
+		StringValue: "qwe",
+	}).Return(nil)
+	err := storeServicePrincipalSecret(context.Background(), w.WorkspaceClient, ServicePrincipalSecretStorage{
+		WorkspaceId: 456,
+		Scope:       "sp",
+		Key:         "client_secret",
+	}, "qwe")
+	assert.NoError(t, err)
+}
+
+func TestServicePrincipalSecretDeleteWithPreviousSecrets(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			e := a.GetMockServicePrincipalSecretsAPI().EXPECT()
+			for _, id := range []string{"003", "004"} {
+				e.Delete(mock.Anything, oauth2.DeleteServicePrincipalSecretRequest{
+					ServicePrincipalId: "123",
+					SecretId:           id,
+				}).Return(nil)
+			}
+		},
+		Resource:  ResourceServicePrincipalSecret(),
+		ID:        "004",
+		Delete:    true,
+		AccountID: "xyz",
+		InstanceState: map[string]string{
+			"service_principal_id":  "123",
+			"previous_secrets.#":    "1",
+			"previous_secrets.0.id": "003",
+		},
+		HCL: `
+		service_principal_id = "123"
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestSecretsToRevoke(t *testing.T) {
+	now := time.Now()
+	previous := []PreviousServicePrincipalSecret{
+		{Id: "3", RotatedAt: now.Add(-time.Hour).Format(time.RFC3339)},
+		{Id: "2", RotatedAt: now.Add(-48 * time.Hour).Format(time.RFC3339)},
+		{Id: "1", RotatedAt: now.Add(-72 * time.Hour).Format(time.RFC3339)},
+	}
+	assert.Equal(t, []int{1, 2}, secretsToRevoke(previous, 0, "", now))
+	assert.Equal(t, []int{}, secretsToRevoke(previous, 5, "", now))
+	assert.Equal(t, []int{1, 2}, secretsToRevoke(previous, 5, "24h", now))
+	assert.Equal(t, []int{0, 1, 2}, secretsToRevoke(previous, 1, "", now))
+}
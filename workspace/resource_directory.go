@@ -65,7 +65,8 @@ func ResourceDirectory() common.Resource {
 	}
 
 	return common.Resource{
-		Schema: s,
+		Schema:   s,
+		Identity: common.NewIdentity("path"),
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			client, err := c.WorkspaceClient()
 			if err != nil {
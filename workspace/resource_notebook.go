@@ -274,6 +274,7 @@ func ResourceNotebook() common.Resource {
 	return common.Resource{
 		Schema:        s,
 		SchemaVersion: 1,
+		Identity:      common.NewIdentity("path"),
 		CanSkipReadAfterCreateAndUpdate: func(d *schema.ResourceData) bool {
 			return d.Get("format").(string) == "SOURCE"
 		},
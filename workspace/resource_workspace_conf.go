@@ -15,6 +15,7 @@ import (
 	"strings"
 
 	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/terraform-provider-databricks/access"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/internal/docs"
 
@@ -68,6 +69,13 @@ func applyWorkspaceConf(ctx context.Context, d *schema.ResourceData, c *common.D
 	if err != nil {
 		return err
 	}
+	var warning *common.Warning
+	if enablesIpAccessLists(old, new) && !d.Get("force").(bool) {
+		warning, err = access.CheckIpAccessListsLockout(ctx, w, c, nil)
+		if err != nil {
+			return fmt.Errorf("cannot enable IP access lists: %w", err)
+		}
+	}
 	err = SafeSetStatus(ctx, w, removed, patch)
 	if err != nil {
 		return err
@@ -77,22 +85,34 @@ func applyWorkspaceConf(ctx context.Context, d *schema.ResourceData, c *common.D
 		newConfig[k] = v
 	}
 	d.SetId("_")
+	if warning != nil {
+		return warning
+	}
 	return nil
 
 }
 
+// enablesIpAccessLists returns true if the change turns IP access lists on
+func enablesIpAccessLists(old, new map[string]any) bool {
+	isEnabled := func(conf map[string]any) bool {
+		enabled, err := strconv.ParseBool(strings.ToLower(fmt.Sprint(conf[access.EnableIpAccessLists])))
+		return err == nil && enabled
+	}
+	return isEnabled(new) && !isEnabled(old)
+}
+
 func updateWorkspaceConf(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 	err := applyWorkspaceConf(ctx, d, c)
-	if err != nil {
+	var warning *common.Warning
+	if err != nil && !errors.As(err, &warning) {
 		// Update methods from the Terraform SDK persist terraform configuration
 		// changes to the state by default, even if update fails.
 		// We revert back to the previous version of the configuration to prevent an
 		// invalid workspace configuration from being persisted in the terraform state.
 		prevConf, _ := d.GetChange("custom_config")
 		d.Set("custom_config", prevConf)
-		return err
 	}
-	return nil
+	return err
 }
 
 func deleteWorkspaceConf(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -249,6 +269,10 @@ func ResourceWorkspaceConf() common.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
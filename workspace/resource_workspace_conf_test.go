@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/databricks/databricks-sdk-go/service/settings"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
 	"github.com/stretchr/testify/assert"
@@ -336,3 +337,69 @@ func TestWorkspaceConfUpdateOnInvalidConf(t *testing.T) {
 		"some-valid-conf": "bar",
 	}, config)
 }
+
+func TestWorkspaceConfCreate_IpAccessListsLockout(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/ip-access-lists",
+				Response: settings.ListIpAccessListResponse{
+					IpAccessLists: []settings.IpAccessListInfo{
+						{
+							ListId:      "123",
+							Label:       "office",
+							ListType:    settings.ListTypeAllow,
+							IpAddresses: []string{"10.1.2.0/24"},
+							Enabled:     true,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceWorkspaceConf(),
+		HCL: `custom_config {
+			enableIpAccessLists = "true"
+		}`,
+		SelfIP: "192.168.1.1",
+		Create: true,
+	}.ExpectError(t, "cannot enable IP access lists: none of ALLOW lists contains the public IP address "+
+		"192.168.1.1 of this Terraform run. Set `force = true` if this is intended")
+}
+
+func TestWorkspaceConfCreate_IpAccessListsForce(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/workspace-conf",
+				ExpectedRequest: map[string]string{
+					"enableIpAccessLists": "true",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace-conf?keys=enableIpAccessLists",
+				Response: map[string]any{
+					"enableIpAccessLists": "true",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceConf(),
+		HCL: `custom_config {
+			enableIpAccessLists = "true"
+		}
+		force = true`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":    "_",
+		"force": true,
+	})
+}
+
+func TestEnablesIpAccessLists(t *testing.T) {
+	assert.True(t, enablesIpAccessLists(map[string]any{}, map[string]any{"enableIpAccessLists": "TRUE"}))
+	assert.True(t, enablesIpAccessLists(map[string]any{"enableIpAccessLists": "false"}, map[string]any{"enableIpAccessLists": true}))
+	assert.False(t, enablesIpAccessLists(map[string]any{"enableIpAccessLists": "true"}, map[string]any{"enableIpAccessLists": "true"}))
+	assert.False(t, enablesIpAccessLists(map[string]any{}, map[string]any{"enableIpAccessLists": "false"}))
+}
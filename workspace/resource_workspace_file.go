@@ -32,6 +32,7 @@ func ResourceWorkspaceFile() common.Resource {
 	return common.Resource{
 		Schema:        s,
 		SchemaVersion: 1,
+		Identity:      common.NewIdentity("path"),
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			content, err := ReadContent(d)
 			if err != nil {